@@ -11,8 +11,12 @@ import (
 func main() {
 	rootCmd := command.NewRootCommand()
 	postgresCmd := command.NewPostgresCommand()
+	mysqlCmd := command.NewMySQLCommand()
+	sqliteCmd := command.NewSQLiteCommand()
 
 	rootCmd.AddCommand(postgresCmd)
+	rootCmd.AddCommand(mysqlCmd)
+	rootCmd.AddCommand(sqliteCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatalf("failed to run: %v", err)
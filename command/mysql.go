@@ -0,0 +1,54 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/kmtym1998/chair/generator"
+	"github.com/kmtym1998/chair/generator/config"
+	"github.com/kmtym1998/chair/mysql"
+	"github.com/kmtym1998/chair/mysql/client"
+	"github.com/spf13/cobra"
+)
+
+func NewMySQLCommand() *cobra.Command {
+	mysqlCmd := &cobra.Command{
+		Use:  "mysql",
+		Long: "generate Go struct from MySQL/MariaDB table schema",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dsn, err := cmd.Flags().GetString("dsn")
+			if err != nil {
+				return fmt.Errorf("failed to get dsn flag: %w", err)
+			}
+
+			cfg, ok := config.From(cmd.Context())
+			if !ok {
+				cfg = config.Default()
+			}
+
+			mysqlClient, err := client.New(client.Opts{
+				DataSourceName: dsn,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create mysql client: %w", err)
+			}
+
+			mysqlLoader := mysql.NewSchemaLoader(mysqlClient.DB(), cfg.MySQL.Schema)
+
+			g := generator.New(
+				cfg,
+				mysql.DefaultMappers(),
+				mysqlLoader,
+			)
+
+			return runGenerator(cmd, g)
+		},
+	}
+
+	mysqlCmd.Flags().String("dsn", "", "MySQL data source name, e.g. user:password@tcp(localhost:3306)/dbname?parseTime=true")
+	if err := mysqlCmd.MarkFlagRequired("dsn"); err != nil {
+		panic(err)
+	}
+
+	return mysqlCmd
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kmtym1998/chair/generator/config"
 	"github.com/kmtym1998/chair/postgres"
 	"github.com/kmtym1998/chair/postgres/client"
+	"github.com/kmtym1998/chair/postgres/ddl"
 	"github.com/spf13/cobra"
 )
 
@@ -21,7 +22,40 @@ func NewPostgresCommand() *cobra.Command {
 				return fmt.Errorf("failed to get dsn flag: %w", err)
 			}
 
-			cfg, _ := config.From(cmd.Context())
+			ddlFiles, err := cmd.Flags().GetStringSlice("ddl")
+			if err != nil {
+				return fmt.Errorf("failed to get ddl flag: %w", err)
+			}
+
+			migrationsDir, err := cmd.Flags().GetString("migrations")
+			if err != nil {
+				return fmt.Errorf("failed to get migrations flag: %w", err)
+			}
+
+			cfg, ok := config.From(cmd.Context())
+			if !ok {
+				cfg = config.Default()
+			}
+
+			if migrationsDir != "" {
+				g := generator.New(
+					cfg,
+					postgres.Mappers(cfg.Postgres.MappingProfile),
+					ddl.NewMigrationsSchemaLoader(migrationsDir, cfg.Postgres.Schemas...),
+				)
+
+				return runGenerator(cmd, g)
+			}
+
+			if len(ddlFiles) > 0 {
+				g := generator.New(
+					cfg,
+					postgres.Mappers(cfg.Postgres.MappingProfile),
+					ddl.NewSchemaLoader(ddlFiles, cfg.Postgres.Schemas...),
+				)
+
+				return runGenerator(cmd, g)
+			}
 
 			pgClient, err := client.New(client.Opts{
 				DataSourceName: dsn,
@@ -30,22 +64,23 @@ func NewPostgresCommand() *cobra.Command {
 				return fmt.Errorf("failed to create postgres client: %w", err)
 			}
 
-			pgLoader := postgres.NewSchemaLoader(pgClient.DB(), cfg.Postgres.Schema)
+			pgLoader := postgres.NewSchemaLoader(pgClient.DB(), cfg.Postgres.Schemas...)
 
 			g := generator.New(
 				cfg,
-				postgres.DefaultMappers(),
+				postgres.Mappers(cfg.Postgres.MappingProfile),
 				pgLoader,
 			)
 
-			return g.Run(cmd.Context())
+			return runGenerator(cmd, g)
 		},
 	}
 
 	postgresCmd.Flags().String("dsn", "", "PostgreSQL data source name")
-	if err := postgresCmd.MarkFlagRequired("dsn"); err != nil {
-		panic(err)
-	}
+	postgresCmd.Flags().StringSlice("ddl", nil, "DDL files to load instead of connecting to a database, applied in the given order")
+	postgresCmd.Flags().String("migrations", "", "migrations directory (golang-migrate, goose or plain numbered files) to replay instead of connecting to a database")
+	postgresCmd.MarkFlagsOneRequired("dsn", "ddl", "migrations")
+	postgresCmd.MarkFlagsMutuallyExclusive("dsn", "ddl", "migrations")
 
 	return postgresCmd
 }
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 
+	"github.com/kmtym1998/chair/generator"
 	"github.com/kmtym1998/chair/generator/config"
 	"github.com/lmittmann/tint"
 	"github.com/samber/lo"
@@ -34,6 +35,7 @@ func NewRootCommand() *cobra.Command {
 	const defaultCfgFileName = ".chair.yml"
 	rootCmd.PersistentFlags().StringP("config", "c", defaultCfgFileName, "config file path")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "verbose output")
+	rootCmd.PersistentFlags().Bool("check", false, "print a diff and fail when the generated code is out of date, without writing anything")
 
 	return rootCmd
 }
@@ -47,7 +49,7 @@ func parseConfig(cmd *cobra.Command) error {
 	cfg, err := config.Parse(cfgFileName)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			slog.Warn("config file not found")
+			slog.Warn("config file not found, using the default config")
 
 			return nil
 		}
@@ -67,7 +69,7 @@ func setUpLogger(cmd *cobra.Command) {
 	slog.SetLogLoggerLevel(slog.LevelDebug)
 	slog.SetDefault(
 		slog.New(tint.NewHandler(
-			os.Stdout,
+			cmd.ErrOrStderr(),
 			&tint.Options{
 				Level:     lo.Ternary(debug, slog.LevelDebug, slog.LevelDebug),
 				AddSource: debug,
@@ -76,3 +78,24 @@ func setUpLogger(cmd *cobra.Command) {
 		)),
 	)
 }
+
+// runGenerator writes the generated code, or checks that it is up to date when --check is given.
+func runGenerator(cmd *cobra.Command, g *generator.Generator) error {
+	check, err := cmd.Flags().GetBool("check")
+	if err != nil {
+		return fmt.Errorf("failed to get check flag: %w", err)
+	}
+
+	if !check {
+		return g.Run(cmd.Context())
+	}
+
+	if err := g.Check(cmd.Context(), cmd.OutOrStdout()); err != nil {
+		// the usage is irrelevant to out of date code
+		cmd.SilenceUsage = errors.Is(err, generator.ErrOutOfDate)
+
+		return err
+	}
+
+	return nil
+}
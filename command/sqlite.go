@@ -0,0 +1,60 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kmtym1998/chair/generator"
+	"github.com/kmtym1998/chair/generator/config"
+	"github.com/kmtym1998/chair/sqlite"
+	"github.com/kmtym1998/chair/sqlite/client"
+	"github.com/spf13/cobra"
+)
+
+func NewSQLiteCommand() *cobra.Command {
+	sqliteCmd := &cobra.Command{
+		Use:  "sqlite",
+		Long: "generate Go struct from SQLite table schema",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return fmt.Errorf("failed to get file flag: %w", err)
+			}
+
+			// the driver creates an empty database when the file does not exist
+			if _, err := os.Stat(file); err != nil {
+				return fmt.Errorf("failed to open database file: %w", err)
+			}
+
+			cfg, ok := config.From(cmd.Context())
+			if !ok {
+				cfg = config.Default()
+			}
+
+			sqliteClient, err := client.New(client.Opts{
+				DataSourceName: "file:" + file + "?mode=ro",
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create sqlite client: %w", err)
+			}
+
+			sqliteLoader := sqlite.NewSchemaLoader(sqliteClient.DB())
+
+			g := generator.New(
+				cfg,
+				sqlite.DefaultMappers(),
+				sqliteLoader,
+			)
+
+			return runGenerator(cmd, g)
+		},
+	}
+
+	sqliteCmd.Flags().String("file", "", "path to the SQLite database file")
+	if err := sqliteCmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+
+	return sqliteCmd
+}
@@ -2,27 +2,182 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
 
 type Config struct {
-	PkgName  string         `yaml:"pkgName"`
-	Output   string         `yaml:"output"`
-	Mappings []TypeMapping  `yaml:"mappings"`
-	Postgres PostgresConfig `yaml:"postgres"`
+	PkgName string `yaml:"pkgName"`
+	Output  string `yaml:"output"`
+	// OutputDir switches the output to one file per table in the directory. Output is ignored when it is set.
+	OutputDir string `yaml:"outputDir"`
+	// FileNamePattern is a text/template of the file name per table, executed with Schema, Name (table name) and ModelName.
+	FileNamePattern string `yaml:"fileNamePattern"`
+	EmitRelations   bool   `yaml:"emitRelations"`
+	// EmitRepository generates a repository with CRUD methods per table, which takes a DBTX interface.
+	EmitRepository bool `yaml:"emitRepository"`
+	// OmitDefaultsOnInsert leaves the columns having a default to the database on Insert and InsertBatch of the repository, in addition
+	// to the identity, serial and generated columns which are always left to it.
+	OmitDefaultsOnInsert bool        `yaml:"omitDefaultsOnInsert"`
+	Tags                 []TagConfig `yaml:"tags"`
+	// Templates replaces the built-in model output with files rendered from text/template files.
+	Templates []TemplateConfig `yaml:"templates"`
+	Mappings  []TypeMapping    `yaml:"mappings"`
+	// NullStyle derives the Go type of nullable columns from the mapping of the non-null type: "pointer", "sql", "generic", "guregu" or "pgtype".
+	// Nullable mappings are used as declared when it is empty.
+	NullStyle string `yaml:"nullStyle"`
+	// UnmappedTypes is the strictness for columns whose type has no mapping: "error", "warn" (default) or "allow".
+	UnmappedTypes string `yaml:"unmappedTypes"`
+	// FallbackType is the Go type of the columns whose type has no mapping. interface{} is used when GoType is empty.
+	FallbackType FallbackType `yaml:"fallbackType"`
+	// SchemaLayout places the tables when more than one schema is loaded: "prefix" (default) generates one package
+	// with the struct names prefixed by the schema, and "package" generates a package per schema in a directory named
+	// after the schema next to Output (or in OutputDir).
+	SchemaLayout string `yaml:"schemaLayout"`
+	// Include limits the tables and the columns to generate to the ones matching any of the patterns. Everything is included when empty.
+	Include FilterConfig `yaml:"include"`
+	// Exclude removes the tables and the columns matching any of the patterns after Include is applied.
+	Exclude FilterConfig `yaml:"exclude"`
+	// Overrides customizes the generated code of a table keyed by "table" or of a column keyed by "table.column".
+	Overrides map[string]Override `yaml:"overrides"`
+	Postgres  PostgresConfig      `yaml:"postgres"`
+	MySQL     MySQLConfig         `yaml:"mysql"`
 }
 
+// DefaultFileNamePattern names the file of each table after the table, e.g. "users_gen.go".
+const DefaultFileNamePattern = "{{.Name}}_gen.go"
+
+// DefaultMultiSchemaFileNamePattern replaces DefaultFileNamePattern when the tables of more than one schema are generated
+// into one directory, so that tables of the same name do not collide, e.g. "billing_invoices_gen.go".
+const DefaultMultiSchemaFileNamePattern = "{{.Schema}}_{{.Name}}_gen.go"
+
 type TypeMapping struct {
 	DBType     string `yaml:"dbType"`
 	GoType     string `yaml:"goType"`
 	GoPkg      string `yaml:"goPkg"`
 	IsNullable bool   `yaml:"isNullable"`
+	// IsArray makes the mapping match array columns whose element type is DBType.
+	IsArray bool `yaml:"isArray"`
+	// ArrayDims restricts an array mapping to the number of dimensions. 0 matches any number of dimensions.
+	ArrayDims int `yaml:"arrayDims"`
+	// Length restricts the mapping to columns of the maximum length, e.g. 1 for char(1). 0 matches any length.
+	Length int `yaml:"length"`
+	// Precision restricts the mapping to numeric columns of the precision. 0 matches any precision.
+	Precision int `yaml:"precision"`
+	// Scale restricts the mapping to numeric columns of the scale, e.g. 0 for numeric(12,0).
+	// Numeric columns without a declared precision never match it. nil matches any scale.
+	Scale *int `yaml:"scale"`
+}
+
+// FallbackType is the Go type of the columns whose type has no mapping, e.g. GoType "RawMessage" of GoPkg "encoding/json".
+type FallbackType struct {
+	GoType string `yaml:"goType"`
+	GoPkg  string `yaml:"goPkg"`
+}
+
+const (
+	// UnmappedTypesError fails the generation listing the columns whose type has no mapping.
+	UnmappedTypesError = "error"
+	// UnmappedTypesWarn logs a warning per column whose type has no mapping and generates it with the fallback type.
+	UnmappedTypesWarn = "warn"
+	// UnmappedTypesAllow generates the columns whose type has no mapping with the fallback type silently.
+	UnmappedTypesAllow = "allow"
+)
+
+// TagConfig configures a struct tag of the generated fields.
+// The values of "gorm" and "bun" tags follow the conventions of the ORMs, e.g. `gorm:"column:id;primaryKey"` and `bun:"id,pk"`,
+// and "sqlx" is an alias of "db". "validate" tags are generated only for columns having a maximum length, e.g. `validate:"max=255"`.
+type TagConfig struct {
+	Key string `yaml:"key"`
+	// Style is the naming style of the tag value: "original" (default), "snake", "camel" or "pascal".
+	Style     string `yaml:"style"`
+	OmitEmpty bool   `yaml:"omitempty"`
+}
+
+// TemplateConfig configures a text/template file rendered with the loaded schema.
+// The result is gofmt'ed when Output is a .go file, and written as is otherwise.
+type TemplateConfig struct {
+	Path   string `yaml:"path"`
+	Output string `yaml:"output"`
 }
 
+const (
+	TagStyleOriginal = "original"
+	TagStyleSnake    = "snake"
+	TagStyleCamel    = "camel"
+	TagStylePascal   = "pascal"
+)
+
+// FilterConfig lists the patterns of table names and of column names written as "table.column".
+// A pattern is a glob of path.Match such as "goose_*" or "*.deleted_at", or a regular expression enclosed in slashes
+// such as "/^events_p\d+$/".
+type FilterConfig struct {
+	Tables  []string `yaml:"tables"`
+	Columns []string `yaml:"columns"`
+}
+
+// Override customizes the generated code of a table or a column.
+// StructName and NullStyle apply to tables only, and GoType, GoPkg, FieldName and Tags apply to columns only.
+type Override struct {
+	// GoType and GoPkg replace the Go type of the column regardless of the mappings, e.g. "UserMetadata".
+	GoType string `yaml:"goType"`
+	GoPkg  string `yaml:"goPkg"`
+	// FieldName replaces the struct field name of the column.
+	FieldName string `yaml:"fieldName"`
+	// StructName replaces the model name of the table.
+	StructName string `yaml:"structName"`
+	// NullStyle replaces the global nullStyle for the columns of the table.
+	NullStyle string `yaml:"nullStyle"`
+	// Tags adds struct tags to the field of the column keyed by tag key, replacing the generated ones.
+	Tags map[string]string `yaml:"tags"`
+	// Skip excludes the table or the column from the generated code.
+	Skip bool `yaml:"skip"`
+	// Comment replaces the comment of the table or the column.
+	Comment string `yaml:"comment"`
+}
+
+const (
+	// NullStylePointer derives *T.
+	NullStylePointer = "pointer"
+	// NullStyleSQL derives sql.NullString, sql.NullInt64 etc. when database/sql has one for T, and sql.Null[T] otherwise.
+	NullStyleSQL = "sql"
+	// NullStyleGeneric derives sql.Null[T].
+	NullStyleGeneric = "generic"
+	// NullStyleGuregu derives null.String, null.Int, null.Float, null.Bool and null.Time of github.com/guregu/null, and *T otherwise.
+	NullStyleGuregu = "guregu"
+	// NullStylePgtype derives the pgtype type of github.com/jackc/pgx/v5/pgtype for the database type, and *T otherwise.
+	NullStylePgtype = "pgtype"
+)
+
 type PostgresConfig struct {
+	// Schema is the schema to load. Use Schemas to load more than one schema.
+	Schema string `yaml:"schema"`
+	// Schemas are the schemas to load in one run. Parse sets it from Schema, or to "public" when both are empty.
+	Schemas []string `yaml:"schemas"`
+	// MappingProfile selects the default mappings: "default" (database/sql and lib/pq) or "pgx" (github.com/jackc/pgx/v5/pgtype).
+	MappingProfile string `yaml:"mappingProfile"`
+}
+
+const (
+	SchemaLayoutPrefix  = "prefix"
+	SchemaLayoutPackage = "package"
+)
+
+// DefaultPostgresSchema is the schema loaded when none is configured.
+const DefaultPostgresSchema = "public"
+
+const (
+	MappingProfileDefault = "default"
+	MappingProfilePgx     = "pgx"
+)
+
+type MySQLConfig struct {
+	// Schema is the database to load. The database of the DSN is used when empty.
 	Schema string `yaml:"schema"`
 }
 
@@ -32,6 +187,21 @@ func Parse(cfgFileName string) (*Config, error) {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
+	return parse(cfgFile)
+}
+
+// Default returns the config used without a config file, which has the defaults filled in by Parse.
+func Default() *Config {
+	cfg, err := parse(nil)
+	if err != nil {
+		// the empty config sets nothing to validate
+		panic(err)
+	}
+
+	return cfg
+}
+
+func parse(cfgFile []byte) (*Config, error) {
 	var cfg Config
 	if err := yaml.Unmarshal(cfgFile, &cfg); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config file: %w", err)
@@ -45,9 +215,155 @@ func Parse(cfgFileName string) (*Config, error) {
 		cfg.Output = "model_gen.go"
 	}
 
+	if cfg.FileNamePattern == "" {
+		cfg.FileNamePattern = DefaultFileNamePattern
+	}
+
+	for i, tag := range cfg.Tags {
+		if tag.Key == "" {
+			return nil, fmt.Errorf("tags[%d]: key is required", i)
+		}
+
+		switch tag.Style {
+		case "":
+			cfg.Tags[i].Style = TagStyleOriginal
+		case TagStyleOriginal, TagStyleSnake, TagStyleCamel, TagStylePascal:
+		default:
+			return nil, fmt.Errorf("tags[%d]: unknown style %q", i, tag.Style)
+		}
+	}
+
+	for i, m := range cfg.Mappings {
+		if m.Length < 0 || m.Precision < 0 || (m.Scale != nil && *m.Scale < 0) {
+			return nil, fmt.Errorf("mappings[%d]: length, precision and scale cannot be negative", i)
+		}
+
+		if m.IsArray && (m.Length != 0 || m.Precision != 0 || m.Scale != nil) {
+			return nil, fmt.Errorf("mappings[%d]: length, precision and scale cannot be set with isArray", i)
+		}
+	}
+
+	switch cfg.Postgres.MappingProfile {
+	case "":
+		cfg.Postgres.MappingProfile = MappingProfileDefault
+	case MappingProfileDefault, MappingProfilePgx:
+	default:
+		return nil, fmt.Errorf("postgres.mappingProfile: unknown profile %q", cfg.Postgres.MappingProfile)
+	}
+
+	switch cfg.UnmappedTypes {
+	case "":
+		cfg.UnmappedTypes = UnmappedTypesWarn
+	case UnmappedTypesError, UnmappedTypesWarn, UnmappedTypesAllow:
+	default:
+		return nil, fmt.Errorf("unmappedTypes: unknown strictness %q", cfg.UnmappedTypes)
+	}
+
+	if cfg.FallbackType.GoType == "" && cfg.FallbackType.GoPkg != "" {
+		return nil, errors.New("fallbackType: goType is required with goPkg")
+	}
+
+	switch {
+	case cfg.Postgres.Schema != "" && len(cfg.Postgres.Schemas) > 0:
+		return nil, errors.New("postgres: schema and schemas cannot be set together")
+	case cfg.Postgres.Schema != "":
+		cfg.Postgres.Schemas = []string{cfg.Postgres.Schema}
+	case len(cfg.Postgres.Schemas) == 0:
+		cfg.Postgres.Schemas = []string{DefaultPostgresSchema}
+	}
+
+	switch cfg.SchemaLayout {
+	case "":
+		cfg.SchemaLayout = SchemaLayoutPrefix
+	case SchemaLayoutPrefix, SchemaLayoutPackage:
+	default:
+		return nil, fmt.Errorf("schemaLayout: unknown layout %q", cfg.SchemaLayout)
+	}
+
+	if err := validateNullStyle(cfg.NullStyle); err != nil {
+		return nil, fmt.Errorf("nullStyle: %w", err)
+	}
+
+	for name, filter := range map[string]FilterConfig{"include": cfg.Include, "exclude": cfg.Exclude} {
+		for i, pattern := range filter.Tables {
+			if _, err := CompilePattern(pattern); err != nil {
+				return nil, fmt.Errorf("%s.tables[%d]: %w", name, i, err)
+			}
+		}
+
+		for i, pattern := range filter.Columns {
+			if _, err := CompilePattern(pattern); err != nil {
+				return nil, fmt.Errorf("%s.columns[%d]: %w", name, i, err)
+			}
+		}
+	}
+
+	for key, override := range cfg.Overrides {
+		if err := validateOverride(key, override); err != nil {
+			return nil, fmt.Errorf("overrides.%s: %w", key, err)
+		}
+	}
+
+	for i, tmpl := range cfg.Templates {
+		if tmpl.Path == "" {
+			return nil, fmt.Errorf("templates[%d]: path is required", i)
+		}
+
+		if tmpl.Output == "" {
+			return nil, fmt.Errorf("templates[%d]: output is required", i)
+		}
+	}
+
 	return &cfg, nil
 }
 
+func validateNullStyle(style string) error {
+	switch style {
+	case "", NullStylePointer, NullStyleSQL, NullStyleGeneric, NullStyleGuregu, NullStylePgtype:
+		return nil
+	default:
+		return fmt.Errorf("unknown style %q", style)
+	}
+}
+
+// validateOverride checks that the override sets only the settings applicable to its key.
+// Keys of two parts are either "table.column" or "schema.table", so only the settings common to both are checked.
+func validateOverride(key string, override Override) error {
+	parts := strings.Split(key, ".")
+	if len(parts) > 3 || slices.Contains(parts, "") {
+		return errors.New(`key must be "table", "table.column", "schema.table" or "schema.table.column"`)
+	}
+
+	if override.GoType == "" && override.GoPkg != "" {
+		return errors.New("goType is required with goPkg")
+	}
+
+	if err := validateNullStyle(override.NullStyle); err != nil {
+		return fmt.Errorf("nullStyle: %w", err)
+	}
+
+	switch len(parts) {
+	case 1:
+		switch {
+		case override.GoType != "":
+			return errors.New("goType can be set for columns only")
+		case override.FieldName != "":
+			return errors.New("fieldName can be set for columns only")
+		case len(override.Tags) > 0:
+			return errors.New("tags can be set for columns only")
+		}
+	case 3:
+		switch {
+		case override.StructName != "":
+			return errors.New("structName can be set for tables only")
+		case override.NullStyle != "":
+			return errors.New("nullStyle can be set for tables only")
+		}
+	}
+
+	return nil
+}
+
 type contextKey struct{}
 
 func With(ctx context.Context, cfg *Config) context.Context {
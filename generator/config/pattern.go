@@ -0,0 +1,29 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// CompilePattern compiles the pattern of FilterConfig into a function reporting whether a name matches it.
+func CompilePattern(pattern string) (func(name string) bool, error) {
+	if expr, ok := strings.CutPrefix(pattern, "/"); ok && len(expr) > 0 && strings.HasSuffix(expr, "/") {
+		re, err := regexp.Compile(strings.TrimSuffix(expr, "/"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", pattern, err)
+		}
+
+		return re.MatchString, nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid glob %q: %w", pattern, err)
+	}
+
+	return func(name string) bool {
+		matched, _ := path.Match(pattern, name)
+		return matched
+	}, nil
+}
@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// enumKey returns the key of the enum of the schema, since enums of the same name may exist in more than one schema.
+func enumKey(schema, name string) string {
+	return schema + "." + name
+}
+
+// columnEnumKey returns the key of the enum of the column, which is in the schema of the table unless EnumSchema is set.
+func columnEnumKey(table Table, column Column) string {
+	return enumKey(cmp.Or(column.EnumSchema, table.Schema), column.Enum)
+}
+
+// resolveEnumTypeNames returns Go type names keyed by enumKey.
+// "Enum" is appended to the name when it collides with a model name.
+func (g *Generator) resolveEnumTypeNames(enums []Enum, tables []Table) map[string]string {
+	modelNames := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		modelNames[g.structName(table)] = true
+	}
+
+	names := make(map[string]string, len(enums))
+	for _, enum := range enums {
+		name := g.schemaPrefix(enum.Schema) + Field(enum.Name).ToUpperCamel().String()
+		if modelNames[name] {
+			name += "Enum"
+		}
+
+		names[enumKey(enum.Schema, enum.Name)] = name
+	}
+
+	return names
+}
+
+// enumConstNames returns the constant name for each value of the enum, e.g. "in_progress" -> "OrderStatusInProgress".
+func enumConstNames(typeName string, enum Enum) []string {
+	names := make([]string, len(enum.Values))
+	for i, value := range enum.Values {
+		suffix := Field(value).ToUpperCamel().String()
+		if suffix == "" {
+			suffix = "Value" + strconv.Itoa(i)
+		}
+
+		names[i] = typeName + suffix
+	}
+
+	return names
+}
+
+// generateEnum generates a string type with a constant per value,
+// together with String, IsValid, Values, Scan and Value methods.
+func (g *Generator) generateEnum(enum Enum, typeName string) *jen.Statement {
+	comment := enum.Name
+	if enum.Comment != "" {
+		comment = fmt.Sprintf("%s: %s", enum.Name, enum.Comment)
+	}
+
+	constNames := enumConstNames(typeName, enum)
+	consts := make([]jen.Code, len(enum.Values))
+	constIDs := make([]jen.Code, len(enum.Values))
+	for i, value := range enum.Values {
+		consts[i] = jen.Id(constNames[i]).Id(typeName).Op("=").Lit(value)
+		constIDs[i] = jen.Id(constNames[i])
+	}
+
+	stmt := jen.Comment(comment).Line().
+		Type().Id(typeName).String().Line().Line().
+		Const().Defs(consts...).Line().Line()
+
+	stmt.Comment(fmt.Sprintf("Values returns all values of %s in the order of the definition.", typeName)).Line().
+		Func().Params(jen.Id(typeName)).Id("Values").Params().Index().Id(typeName).Block(
+		jen.Return(jen.Index().Id(typeName).Values(constIDs...)),
+	).Line().Line()
+
+	stmt.Comment("String implements the fmt.Stringer interface.").Line().
+		Func().Params(jen.Id("e").Id(typeName)).Id("String").Params().String().Block(
+		jen.Return(jen.String().Parens(jen.Id("e"))),
+	).Line().Line()
+
+	stmt.Comment(fmt.Sprintf("IsValid reports whether e is one of the values of %s.", typeName)).Line().
+		Func().Params(jen.Id("e").Id(typeName)).Id("IsValid").Params().Bool().Block(
+		jen.Switch(jen.Id("e")).Block(
+			jen.Case(constIDs...).Block(jen.Return(jen.True())),
+		),
+		jen.Return(jen.False()),
+	).Line().Line()
+
+	stmt.Comment("Scan implements the sql.Scanner interface.").Line().
+		Func().Params(jen.Id("e").Op("*").Id(typeName)).Id("Scan").Params(jen.Id("src").Interface()).Error().Block(
+		jen.Switch(jen.Id("v").Op(":=").Id("src").Assert(jen.Type())).Block(
+			jen.Case(jen.String()).Block(jen.Op("*").Id("e").Op("=").Id(typeName).Parens(jen.Id("v"))),
+			jen.Case(jen.Index().Byte()).Block(jen.Op("*").Id("e").Op("=").Id(typeName).Parens(jen.Id("v"))),
+			jen.Default().Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("cannot scan %T into "+typeName), jen.Id("src"))),
+			),
+		),
+		jen.Line(),
+		jen.If(jen.Op("!").Id("e").Dot("IsValid").Call()).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("invalid "+typeName+": %q"), jen.Op("*").Id("e"))),
+		),
+		jen.Line(),
+		jen.Return(jen.Nil()),
+	).Line().Line()
+
+	stmt.Comment("Value implements the driver.Valuer interface.").Line().
+		Func().Params(jen.Id("e").Id(typeName)).Id("Value").Params().Params(jen.Qual("database/sql/driver", "Value"), jen.Error()).Block(
+		jen.If(jen.Op("!").Id("e").Dot("IsValid").Call()).Block(
+			jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("invalid "+typeName+": %q"), jen.Id("e"))),
+		),
+		jen.Line(),
+		jen.Return(jen.String().Parens(jen.Id("e")), jen.Nil()),
+	)
+
+	return stmt
+}
@@ -0,0 +1,175 @@
+package generator
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/kmtym1998/chair/generator/config"
+	"github.com/samber/lo"
+)
+
+// pattern is a compiled pattern of the include and exclude filters.
+type pattern struct {
+	source string
+	match  func(name string) bool
+}
+
+func compilePatterns(sources []string) ([]pattern, error) {
+	patterns := make([]pattern, len(sources))
+	for i, source := range sources {
+		match, err := config.CompilePattern(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile filter pattern: %w", err)
+		}
+
+		patterns[i] = pattern{source: source, match: match}
+	}
+
+	return patterns, nil
+}
+
+// matchPattern returns the first pattern matching any of the names.
+func matchPattern(patterns []pattern, names []string) (string, bool) {
+	for _, p := range patterns {
+		if slices.ContainsFunc(names, p.match) {
+			return p.source, true
+		}
+	}
+
+	return "", false
+}
+
+// filter holds the include and exclude patterns of either tables or columns.
+type filter struct {
+	include []pattern
+	exclude []pattern
+}
+
+// included reports whether any of the names matches any of the include patterns. Everything is included without them.
+func (f filter) included(names []string) bool {
+	if len(f.include) == 0 {
+		return true
+	}
+
+	_, ok := matchPattern(f.include, names)
+	return ok
+}
+
+// excludedBy returns the exclude pattern matching any of the names.
+func (f filter) excludedBy(names []string) (string, bool) {
+	return matchPattern(f.exclude, names)
+}
+
+// filterTables removes the tables and the columns filtered out by the include and exclude settings of the config.
+// Tables are matched as "table" and "schema.table", and columns as "table.column" and "schema.table.column".
+// Each filtered table and column is logged at debug level.
+// The keys, the indexes and the foreign keys referring to the filtered tables and columns are removed as well
+// so that no relation points at a struct or a field which is not generated.
+func (g *Generator) filterTables(tables []Table) ([]Table, error) {
+	var tableFilter, columnFilter filter
+	for _, f := range []struct {
+		dst     *[]pattern
+		sources []string
+	}{
+		{dst: &tableFilter.include, sources: g.config.Include.Tables},
+		{dst: &tableFilter.exclude, sources: g.config.Exclude.Tables},
+		{dst: &columnFilter.include, sources: g.config.Include.Columns},
+		{dst: &columnFilter.exclude, sources: g.config.Exclude.Columns},
+	} {
+		patterns, err := compilePatterns(f.sources)
+		if err != nil {
+			return nil, err
+		}
+		*f.dst = patterns
+	}
+
+	filtered := make([]Table, 0, len(tables))
+	for _, table := range tables {
+		tableNames := tableKeys(table, "")
+		if !tableFilter.included(tableNames) {
+			slog.Debug("filtering out table not included", "table", table.Name)
+			continue
+		}
+
+		if source, ok := tableFilter.excludedBy(tableNames); ok {
+			slog.Debug("filtering out excluded table", "table", table.Name, "pattern", source)
+			continue
+		}
+
+		columns := make([]Column, 0, len(table.Columns))
+		for _, column := range table.Columns {
+			name := table.Name + "." + column.Name
+			columnNames := tableKeys(table, column.Name)
+			// the primary key is kept to identify the rows, so it cannot be excluded and is always included
+			isPrimaryKey := slices.Contains(table.PrimaryKey, column.Name)
+
+			if !isPrimaryKey && !columnFilter.included(columnNames) {
+				slog.Debug("filtering out column not included", "column", name)
+				continue
+			}
+
+			if source, ok := columnFilter.excludedBy(columnNames); ok {
+				if isPrimaryKey {
+					return nil, fmt.Errorf("failed to exclude column %s by %q: primary key columns cannot be excluded", name, source)
+				}
+
+				slog.Debug("filtering out excluded column", "column", name, "pattern", source)
+				continue
+			}
+
+			columns = append(columns, column)
+		}
+		table.Columns = columns
+
+		filtered = append(filtered, table)
+	}
+
+	return pruneReferences(filtered), nil
+}
+
+// pruneReferences removes the unique constraints, the indexes and the foreign keys
+// referring to the columns or the tables which are not in the tables.
+func pruneReferences(tables []Table) []Table {
+	columnsByTable := make(map[string][]string, len(tables))
+	for _, table := range tables {
+		columnsByTable[table.Schema+"."+table.Name] = lo.Map(table.Columns, func(c Column, _ int) string { return c.Name })
+	}
+	hasColumns := func(schema, table string, names []string) bool {
+		columns, ok := columnsByTable[schema+"."+table]
+		return ok && lo.Every(columns, names)
+	}
+
+	for i, table := range tables {
+		tables[i].UniqueConstraints = lo.Reject(table.UniqueConstraints, func(u UniqueConstraint, _ int) bool {
+			if hasColumns(table.Schema, table.Name, u.Columns) {
+				return false
+			}
+
+			slog.Debug("filtering out unique constraint on filtered column", "table", table.Name, "constraint", u.Name)
+			return true
+		})
+
+		tables[i].Indexes = lo.Reject(table.Indexes, func(idx Index, _ int) bool {
+			if hasColumns(table.Schema, table.Name, idx.Columns) {
+				return false
+			}
+
+			slog.Debug("filtering out index on filtered column", "table", table.Name, "index", idx.Name)
+			return true
+		})
+
+		tables[i].ForeignKeys = lo.Reject(table.ForeignKeys, func(fk ForeignKey, _ int) bool {
+			if hasColumns(table.Schema, table.Name, fk.Columns) &&
+				hasColumns(cmp.Or(fk.RefSchema, table.Schema), fk.RefTable, fk.RefColumns) {
+				return false
+			}
+
+			slog.Debug("filtering out foreign key referring to filtered table or column", "table", table.Name, "foreign_key", fk.Name)
+			return true
+		})
+	}
+
+	return tables
+}
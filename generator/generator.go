@@ -1,21 +1,35 @@
 package generator
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
+	"strings"
 
 	"github.com/dave/jennifer/jen"
 	"github.com/kmtym1998/chair/generator/config"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/samber/lo"
 )
 
 type Generator struct {
 	config       *config.Config
 	mappings     []config.TypeMapping
 	schemaLoader SchemaLoader
+
+	// enumTypeNames holds Go type names of the loaded enums keyed by "schema.enum".
+	enumTypeNames map[string]string
+	// dialect is the SQL dialect of the schema loader.
+	dialect Dialect
+	// multiSchema is true when the loaded tables belong to more than one schema.
+	multiSchema bool
 }
 
 func New(
@@ -34,33 +48,279 @@ func New(
 	}
 }
 
+// ErrOutOfDate is returned by Check when the generated code differs from the files on disk.
+var ErrOutOfDate = errors.New("generated code is out of date")
+
+// output is a generated file.
+type output struct {
+	path    string
+	content []byte
+}
+
+// Run generates the code and writes it, deleting the stale files of the previous run.
 func (g *Generator) Run(ctx context.Context) error {
+	outputs, stalePaths, err := g.generate(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range outputs {
+		if err := writeFile(o.path, o.content); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range stalePaths {
+		slog.Info("deleting stale file", "path", path)
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to delete stale file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Check generates the code in memory and writes a unified diff against the files on disk to w without writing anything.
+// It returns ErrOutOfDate when the generated code differs, including files which would be deleted.
+func (g *Generator) Check(ctx context.Context, w io.Writer) error {
+	outputs, stalePaths, err := g.generate(ctx)
+	if err != nil {
+		return err
+	}
+
+	upToDate := true
+	for _, o := range outputs {
+		current, exists, err := readCurrent(o.path)
+		if err != nil {
+			return err
+		}
+
+		if exists && bytes.Equal(current, o.content) {
+			continue
+		}
+
+		upToDate = false
+		fromFile := lo.Ternary(exists, "a/"+o.path, "/dev/null")
+		if err := writeDiff(w, fromFile, "b/"+o.path, current, o.content); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range stalePaths {
+		current, exists, err := readCurrent(path)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			continue
+		}
+
+		upToDate = false
+		if err := writeDiff(w, "a/"+path, "/dev/null", current, nil); err != nil {
+			return err
+		}
+	}
+
+	if !upToDate {
+		return ErrOutOfDate
+	}
+
+	return nil
+}
+
+// generate loads the schema and returns the files to write and the paths of the stale files to delete.
+func (g *Generator) generate(ctx context.Context) ([]output, []string, error) {
 	// Load schema
 	tables, err := g.schemaLoader.LoadTableSchemas(ctx)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	tables, err = g.filterTables(tables)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tables, err = g.applyOverrides(tables)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tables = g.resolveRelations(tables)
+
+	var enums []Enum
+	if enumLoader, ok := g.schemaLoader.(EnumLoader); ok {
+		enums, err = enumLoader.LoadEnums(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	g.dialect = DialectPostgres
+	if dialectLoader, ok := g.schemaLoader.(DialectLoader); ok {
+		g.dialect = dialectLoader.Dialect()
+	}
+
+	schemas := schemaNames(tables)
+	g.multiSchema = len(schemas) > 1
+	if !g.multiSchema || g.config.SchemaLayout != config.SchemaLayoutPackage {
+		return g.render(tables, enums)
+	}
+
+	var (
+		outputs    []output
+		stalePaths []string
+	)
+	for _, schema := range schemas {
+		// relations are resolved again within the schema since another package cannot be referenced
+		schemaTables := g.resolveRelations(lo.Filter(tables, func(table Table, _ int) bool { return table.Schema == schema }))
+		schemaEnums := lo.Filter(enums, func(enum Enum, _ int) bool { return enum.Schema == schema })
+
+		o, stale, err := g.schemaGenerator(schema).render(schemaTables, schemaEnums)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate schema %s: %w", schema, err)
+		}
+
+		outputs = append(outputs, o...)
+		stalePaths = append(stalePaths, stale...)
+	}
+
+	return outputs, stalePaths, nil
+}
+
+// render generates the files of the tables and the enums according to the output settings.
+func (g *Generator) render(tables []Table, enums []Enum) ([]output, []string, error) {
+	g.enumTypeNames = g.resolveEnumTypeNames(enums, tables)
+
+	if err := g.checkUnmappedTypes(tables); err != nil {
+		return nil, nil, err
+	}
+
+	if len(g.config.Templates) > 0 {
+		outputs, err := g.renderTemplates(tables, enums)
+		return outputs, nil, err
+	}
+
+	if g.config.OutputDir != "" {
+		return g.dirOutputs(tables, enums)
+	}
+
+	if filepath.Ext(g.config.Output) != ".go" {
+		return nil, nil, errors.New("output file must be a .go file")
 	}
 
 	// Create a new file
-	file := jen.NewFile(g.config.PkgName)
-	file.Comment("Code generated by github.com/kmtym1998/chair. DO NOT EDIT.").Line()
+	file := newFile(g.config.PkgName)
 
 	// Generate code
+	for _, enum := range enums {
+		file.Add(g.generateEnum(enum, g.enumTypeNames[enumKey(enum.Schema, enum.Name)]))
+	}
+
+	if g.emitsDBTX(tables) {
+		file.Add(generateDBTX())
+	}
+
 	for _, table := range tables {
-		stmt := g.generateTableStruct(table)
-		file.Add(stmt)
+		g.generateTable(file, table)
 	}
 
-	// Write to file
-	if err := g.export(file); err != nil {
-		return err
+	o, err := renderFile(g.config.Output, file)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return nil
+	return []output{o}, nil, nil
+}
+
+// schemaNames returns the schemas of the tables in the order of appearance.
+func schemaNames(tables []Table) []string {
+	var schemas []string
+	for _, table := range tables {
+		if table.Schema != "" && !slices.Contains(schemas, table.Schema) {
+			schemas = append(schemas, table.Schema)
+		}
+	}
+
+	return schemas
+}
+
+// schemaGenerator returns a copy of the generator writing into the package of the schema, which is placed in
+// the directory named after the schema next to the configured output.
+func (g *Generator) schemaGenerator(schema string) *Generator {
+	cfg := *g.config
+	cfg.PkgName = schemaPackageName(schema)
+	cfg.Output = filepath.Join(filepath.Dir(cfg.Output), schema, filepath.Base(cfg.Output))
+	if cfg.OutputDir != "" {
+		cfg.OutputDir = filepath.Join(cfg.OutputDir, schema)
+	}
+
+	cfg.Templates = make([]config.TemplateConfig, len(g.config.Templates))
+	for i, tmpl := range g.config.Templates {
+		tmpl.Output = filepath.Join(filepath.Dir(tmpl.Output), schema, filepath.Base(tmpl.Output))
+		cfg.Templates[i] = tmpl
+	}
+
+	schemaGenerator := *g
+	schemaGenerator.config = &cfg
+
+	return &schemaGenerator
+}
+
+// schemaPackageName returns the Go package name of the schema, e.g. "billingv2" for "Billing-V2".
+func schemaPackageName(schema string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case 'a' <= r && r <= 'z', '0' <= r && r <= '9', r == '_':
+			return r
+		case 'A' <= r && r <= 'Z':
+			return r + 'a' - 'A'
+		default:
+			return -1
+		}
+	}, schema)
+
+	if name == "" || ('0' <= name[0] && name[0] <= '9') {
+		return "schema" + name
+	}
+
+	return name
+}
+
+func newFile(pkgName string) *jen.File {
+	file := jen.NewFile(pkgName)
+	file.Comment("Code generated by github.com/kmtym1998/chair. DO NOT EDIT.").Line()
+
+	return file
+}
+
+// generateTable adds the model of the table and its methods to the file, together with the repository when enabled.
+func (g *Generator) generateTable(file *jen.File, table Table) {
+	sort.SliceStable(table.Columns, func(i, j int) bool {
+		return table.Columns[i].OrderAsc < table.Columns[j].OrderAsc
+	})
+
+	file.Add(g.generateTableStruct(table))
+
+	if len(table.PrimaryKey) > 0 && !g.structFieldNames(table)["PrimaryKey"] {
+		file.Add(g.generatePrimaryKeyMethod(table))
+	}
+
+	file.Add(g.generateTableMetadata(table))
+
+	if g.config.EmitRepository && hasRepository(table) {
+		file.Add(g.generateRepository(table))
+	}
+}
+
+// emitsDBTX reports whether any repository is generated, which requires the DBTX interface.
+func (g *Generator) emitsDBTX(tables []Table) bool {
+	return g.config.EmitRepository && slices.ContainsFunc(tables, hasRepository)
 }
 
 func (g *Generator) generateTableStruct(table Table) *jen.Statement {
-	modelName := Field(table.Name).ToUpperCamel().ToSingular().String()
+	modelName := g.structName(table)
 
 	comment := func() string {
 		if table.Comment == "" {
@@ -71,13 +331,32 @@ func (g *Generator) generateTableStruct(table Table) *jen.Statement {
 	}()
 	structStmt := jen.Comment(comment).Line()
 
+	var detailComments []string
+	if table.IsReadOnly() {
+		detailComments = append(detailComments, fmt.Sprintf("read-only: %s", strings.ReplaceAll(table.Kind.String(), "_", " ")))
+	}
+	detailComments = append(detailComments, tableKeyComments(table)...)
+
+	if len(detailComments) > 0 {
+		structStmt.Comment("").Line()
+		for _, c := range detailComments {
+			structStmt.Comment(c).Line()
+		}
+	}
+
 	sort.SliceStable(table.Columns, func(i, j int) bool {
 		return table.Columns[i].OrderAsc < table.Columns[j].OrderAsc
 	})
 
-	structFields := make([]jen.Code, len(table.Columns))
+	structFields := make([]jen.Code, 0, len(table.Columns)+len(table.Relations))
 	for i, column := range table.Columns {
-		structFields[i] = g.generateTableStructField(table, column, i == 0)
+		structFields = append(structFields, g.generateTableStructField(table, column, i == 0))
+	}
+
+	if g.config.EmitRelations {
+		for _, relation := range table.Relations {
+			structFields = append(structFields, g.generateRelationField(table, relation))
+		}
 	}
 
 	structStmt.Type().Id(modelName).Struct(structFields...)
@@ -85,6 +364,53 @@ func (g *Generator) generateTableStruct(table Table) *jen.Statement {
 	return structStmt
 }
 
+// tableKeyComments describes the primary key and the indexes of the table, one line each.
+// Indexes backing the primary key are omitted since they are described by the primary key line.
+func tableKeyComments(table Table) []string {
+	var comments []string
+	if len(table.PrimaryKey) > 0 {
+		comments = append(comments, fmt.Sprintf("primary key: (%s)", strings.Join(table.PrimaryKey, ", ")))
+	}
+
+	for _, idx := range table.Indexes {
+		if idx.IsPrimary {
+			continue
+		}
+
+		kind := lo.Ternary(idx.IsUnique, "unique index", "index")
+		keys := fmt.Sprintf("(%s)", strings.Join(idx.Columns, ", "))
+		if idx.HasExpression {
+			// e.g. "CREATE INDEX ... USING btree (lower(name))" -> "(lower(name))"
+			keys = idx.Definition
+			if _, after, ok := strings.Cut(idx.Definition, " USING "); ok {
+				if i := strings.Index(after, "("); i >= 0 {
+					keys = after[i:]
+				}
+			}
+		}
+
+		comments = append(comments, fmt.Sprintf("%s: %s %s", kind, idx.Name, keys))
+	}
+
+	return comments
+}
+
+// generatePrimaryKeyMethod generates a method which returns the column names of the primary key.
+func (g *Generator) generatePrimaryKeyMethod(table Table) *jen.Statement {
+	modelName := g.structName(table)
+
+	columnNames := make([]jen.Code, len(table.PrimaryKey))
+	for i, name := range table.PrimaryKey {
+		columnNames[i] = jen.Lit(name)
+	}
+
+	return jen.Comment(fmt.Sprintf("PrimaryKey returns the column names of the primary key of %s.", table.Name)).
+		Line().
+		Func().Params(jen.Id(modelName)).Id("PrimaryKey").Params().Index().String().Block(
+		jen.Return(jen.Index().String().Values(columnNames...)),
+	)
+}
+
 func (g *Generator) generateTableStructField(table Table, column Column, isFirstField bool) *jen.Statement {
 	comment := func() string {
 		if column.Comment == "" {
@@ -103,44 +429,196 @@ func (g *Generator) generateTableStructField(table Table, column Column, isFirst
 
 	fieldStmt.
 		Line().
-		Id(Field(column.Name).
-			ToUpperCamel().
-			String(),
-		)
-
-	mapping, ok := g.findMappingByDBType(column.Type, column.IsNullable)
-	if ok {
-		fieldStmt.Qual(mapping.GoPkg, mapping.GoType)
-	} else {
-		fieldStmt.Interface()
+		Id(g.fieldName(table, column.Name))
+
+	typeStmt, _ := g.fieldType(table, column)
+
+	return fieldStmt.Add(typeStmt).Tag(g.columnTags(table, column))
+}
+
+// fieldType resolves the Go type of the column of the table. The Go type of the override of the column takes precedence.
+// It returns false with interface{} when no mapping is found.
+func (g *Generator) fieldType(table Table, column Column) (*jen.Statement, bool) {
+	if override, ok := g.columnOverride(table, column.Name); ok && override.GoType != "" {
+		return qualType(override.GoPkg, override.GoType), true
+	}
+
+	if column.ArrayDims > 0 {
+		return g.arrayFieldType(table, column)
+	}
+
+	if column.IsNullable {
+		if style := g.nullStyle(table); style != "" {
+			return g.styledNullableFieldType(table, column, style)
+		}
+	}
+
+	if column.Enum != "" {
+		// mappings for the enum name take precedence over the generated enum type
+		if mapping, ok := g.findMappingByDBType(column.Enum, column, column.IsNullable); ok {
+			return qualType(mapping.GoPkg, mapping.GoType), true
+		}
+
+		if typeName, ok := g.enumTypeNames[columnEnumKey(table, column)]; ok {
+			if column.IsNullable {
+				return jen.Op("*").Id(typeName), true
+			}
+
+			return jen.Id(typeName), true
+		}
 	}
 
-	return fieldStmt
+	mapping, ok := g.findMappingByDBType(column.Type, column, column.IsNullable)
+	if !ok {
+		return g.fallbackType(), false
+	}
+
+	return qualType(mapping.GoPkg, mapping.GoType), true
 }
 
-func (g *Generator) export(file *jen.File) error {
-	if filepath.Ext(g.config.Output) != ".go" {
-		return errors.New("output file must be a .go file")
+// arrayFieldType resolves the Go type of the array column.
+// Array mappings for the element type are used first. A non-nullable array mapping also applies to nullable columns
+// since array types represent NULL by themselves (e.g. a nil slice). When the config maps the element type itself,
+// only the array mappings of the config are used so that the elements do not get another type than the scalar columns.
+// Otherwise the type is derived as a slice of the non-nullable element type, e.g. uuid[] -> []uuid.UUID, with the pgx
+// profile only since database/sql cannot scan arrays into slices. The column is unmapped with the other profiles.
+func (g *Generator) arrayFieldType(table Table, column Column) (*jen.Statement, bool) {
+	elemType := lo.Ternary(column.Enum != "", column.Enum, column.ElemType)
+
+	mappings := g.mappings
+	if slices.ContainsFunc(g.config.Mappings, func(m config.TypeMapping) bool { return m.DBType == elemType && !m.IsArray }) {
+		mappings = g.config.Mappings
 	}
 
-	dir := filepath.Dir(g.config.Output)
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		if err := os.MkdirAll(dir, 0755); err != nil {
-			return err
+	for _, isNullable := range lo.Uniq([]bool{column.IsNullable, false}) {
+		for _, m := range mappings {
+			if m.IsArray &&
+				m.DBType == elemType &&
+				m.IsNullable == isNullable &&
+				(m.ArrayDims == 0 || m.ArrayDims == column.ArrayDims) {
+				return qualType(m.GoPkg, m.GoType), true
+			}
 		}
 	}
 
-	if _, err := os.Create(g.config.Output); err != nil {
-		return err
+	if g.config.Postgres.MappingProfile != config.MappingProfilePgx {
+		return g.fallbackType(), false
+	}
+
+	elemStmt, ok := g.fieldType(table, Column{
+		Name:       column.Name,
+		Type:       column.ElemType,
+		Enum:       column.Enum,
+		EnumSchema: column.EnumSchema,
+		IsNullable: false,
+	})
+	if !ok {
+		return g.fallbackType(), false
+	}
+
+	typeStmt := &jen.Statement{}
+	for range column.ArrayDims {
+		typeStmt.Index()
+	}
+
+	return typeStmt.Add(elemStmt), true
+}
+
+// qualType returns the Go type of the mapping. A leading "*" of the type name declares a pointer type,
+// e.g. "*RawMessage" of encoding/json is rendered as *json.RawMessage.
+func qualType(goPkg, goType string) *jen.Statement {
+	if name, ok := strings.CutPrefix(goType, "*"); ok {
+		return jen.Op("*").Add(qualType(goPkg, name))
+	}
+
+	return jen.Qual(goPkg, goType)
+}
+
+// generateRelationField generates a field holding the related model(s).
+// one_to_many relations are slices of pointers and the others are pointers so that unloaded relations are nil.
+func (g *Generator) generateRelationField(table Table, relation Relation) *jen.Statement {
+	arrow := lo.Ternary(relation.IsInverse, "<-", "->")
+	comment := fmt.Sprintf(
+		"%s.%s: %s %s(%s) %s %s(%s)",
+		table.Name,
+		relation.Name,
+		relation.Type,
+		table.Name,
+		strings.Join(relation.Columns, ", "),
+		arrow,
+		relation.RefTable,
+		strings.Join(relation.RefColumns, ", "),
+	)
+
+	fieldStmt := jen.Line().Comment(comment).Line().Id(relation.Name)
+	if relation.Type == RelationTypeOneToMany {
+		fieldStmt.Index()
+	}
+
+	return fieldStmt.Op("*").Id(g.structName(relation.refTable())).Tag(g.relationTags(table, relation))
+}
+
+// modelName returns the struct name for the table.
+func modelName(tableName string) string {
+	return Field(tableName).ToUpperCamel().ToSingular().String()
+}
+
+func renderFile(path string, file *jen.File) (output, error) {
+	var buf bytes.Buffer
+	if err := file.Render(&buf); err != nil {
+		return output{}, fmt.Errorf("failed to render %s: %w", path, err)
+	}
+
+	return output{path: path, content: buf.Bytes()}, nil
+}
+
+// readCurrent reads the file on disk. The second return value is false when the file does not exist.
+func readCurrent(path string) ([]byte, bool, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read current file: %w", err)
+	}
+
+	return content, true, nil
+}
+
+func writeDiff(w io.Writer, fromFile, toFile string, from, to []byte) error {
+	err := difflib.WriteUnifiedDiff(w, difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(from)),
+		B:        difflib.SplitLines(string(to)),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write diff: %w", err)
 	}
 
-	return file.Save(g.config.Output)
+	return nil
 }
 
-func (g *Generator) findMappingByDBType(dbType string, isNullable bool) (config.TypeMapping, bool) {
+// writeFile writes the content to the path, creating the parent directories.
+func writeFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	return nil
+}
+
+// findMappingByDBType returns the first non-array mapping of the database type whose length, precision and scale
+// restrictions match the column.
+func (g *Generator) findMappingByDBType(dbType string, column Column, isNullable bool) (config.TypeMapping, bool) {
 	var mapping config.TypeMapping
 	for _, m := range g.mappings {
-		if m.DBType == dbType && m.IsNullable == isNullable {
+		if m.DBType == dbType && m.IsNullable == isNullable && !m.IsArray && matchesSize(m, column) {
 			mapping = m
 			return mapping, true
 		}
@@ -148,3 +626,11 @@ func (g *Generator) findMappingByDBType(dbType string, isNullable bool) (config.
 
 	return mapping, false
 }
+
+// matchesSize reports whether the length, precision and scale restrictions of the mapping match the column.
+// A scale restriction matches only numeric columns with a declared precision, since the scale of the others is unknown.
+func matchesSize(m config.TypeMapping, column Column) bool {
+	return (m.Length == 0 || m.Length == column.Length) &&
+		(m.Precision == 0 || m.Precision == column.Precision) &&
+		(m.Scale == nil || (column.Precision > 0 && *m.Scale == column.Scale))
+}
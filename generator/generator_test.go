@@ -1,6 +1,7 @@
 package generator_test
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"path/filepath"
@@ -16,6 +17,21 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
+func assertGoldenFile(t *testing.T, gotFileName, wantFileName string) bool {
+	t.Helper()
+	got, err := os.ReadFile("./golden_testing/got/" + gotFileName)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	want, err := os.ReadFile("./golden_testing/want/" + wantFileName)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	return assert.Equal(t, string(want), string(got))
+}
+
 func TestRun_PostgreSQL(t *testing.T) {
 	mockLdr := generator.NewSchemaLoaderMock()
 	cfg := config.ConfigMock()
@@ -28,21 +44,1206 @@ func TestRun_PostgreSQL(t *testing.T) {
 	}
 
 	t.Run("assert generated code is correct", func(t *testing.T) {
-		assertGoldenFile := func(t *testing.T, gotFileName, wantFileName string) bool {
-			t.Helper()
-			got, err := os.ReadFile("./golden_testing/got/" + gotFileName)
-			if err != nil {
-				t.Fatalf("failed to read file: %v", err)
+		assertGoldenFile(t, filepath.Base(cfg.Output), "01_output.go")
+	})
+}
+
+func TestRun_Relations(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "users",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "name", Type: "text", OrderAsc: 2},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Name: "user_profiles",
+			Columns: []generator.Column{
+				{Name: "user_id", Type: "integer", OrderAsc: 1},
+				{Name: "bio", Type: "text", IsNullable: true, OrderAsc: 2},
+			},
+			PrimaryKey: []string{"user_id"},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "user_profiles_user_id_fkey", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+			},
+		},
+		{
+			Name: "posts",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "author_id", Type: "integer", OrderAsc: 2},
+				{Name: "category_id", Type: "integer", IsNullable: true, OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "posts_author_id_fkey", Columns: []string{"author_id"}, RefTable: "users", RefColumns: []string{"id"}},
+				{Name: "posts_category_id_fkey", Columns: []string{"category_id"}, RefTable: "categories", RefColumns: []string{"id"}},
+			},
+		},
+		{
+			Name: "messages",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "sender_id", Type: "integer", OrderAsc: 2},
+				{Name: "receiver_id", Type: "integer", OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "messages_sender_id_fkey", Columns: []string{"sender_id"}, RefTable: "users", RefColumns: []string{"id"}},
+				{Name: "messages_receiver_id_fkey", Columns: []string{"receiver_id"}, RefTable: "users", RefColumns: []string{"id"}},
+			},
+		},
+		{
+			Name: "categories",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "parent_id", Type: "integer", IsNullable: true, OrderAsc: 2},
+			},
+			PrimaryKey: []string{"id"},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "categories_parent_id_fkey", Columns: []string{"parent_id"}, RefTable: "categories", RefColumns: []string{"id"}},
+			},
+		},
+	})
+	cfg := config.ConfigMock()
+
+	cfg.Output = "./golden_testing/got/02_relations.go"
+	cfg.EmitRelations = true
+
+	gen := generator.New(&cfg, postgres.DefaultMappers(), mockLdr)
+	if err := gen.Run(context.Background()); err != nil {
+		t.Fatalf("failed to generate go file: %v", err)
+	}
+
+	t.Run("assert generated code is correct", func(t *testing.T) {
+		assertGoldenFile(t, filepath.Base(cfg.Output), "02_relations.go")
+	})
+}
+
+func TestRun_Tags(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "users",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "display_name", Type: "text", IsNullable: true, OrderAsc: 2},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Name: "posts",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "author_id", Type: "integer", OrderAsc: 2},
+			},
+			PrimaryKey: []string{"id"},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "posts_author_id_fkey", Columns: []string{"author_id"}, RefTable: "users", RefColumns: []string{"id"}},
+			},
+		},
+	})
+	cfg := config.ConfigMock()
+
+	cfg.Output = "./golden_testing/got/03_tags.go"
+	cfg.EmitRelations = true
+	cfg.Tags = []config.TagConfig{
+		{Key: "sqlx"},
+		{Key: "json", Style: config.TagStyleCamel, OmitEmpty: true},
+		{Key: "yaml", Style: config.TagStylePascal},
+		{Key: "gorm"},
+		{Key: "bun"},
+	}
+
+	gen := generator.New(&cfg, postgres.DefaultMappers(), mockLdr)
+	if err := gen.Run(context.Background()); err != nil {
+		t.Fatalf("failed to generate go file: %v", err)
+	}
+
+	t.Run("assert generated code is correct", func(t *testing.T) {
+		assertGoldenFile(t, filepath.Base(cfg.Output), "03_tags.go")
+	})
+}
+
+func TestRun_Templates(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "users",
+			Columns: []generator.Column{
+				{Name: "id", Type: "uuid", OrderAsc: 1},
+				{Name: "display_name", Type: "text", IsNullable: true, OrderAsc: 2},
+				{Name: "status", Type: "USER-DEFINED", Enum: "user_status", OrderAsc: 3},
+				{Name: "tags", Type: "ARRAY", ElemType: "text", ArrayDims: 1, OrderAsc: 4},
+				{Name: "deleted_at", Type: "timestamp without time zone", IsNullable: true, OrderAsc: 5},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Name: "user_profiles",
+			Columns: []generator.Column{
+				{Name: "user_id", Type: "uuid", OrderAsc: 1},
+				{Name: "bio", Type: "text", IsNullable: true, OrderAsc: 2},
+			},
+			PrimaryKey: []string{"user_id"},
+		},
+	}).WithEnums([]generator.Enum{
+		{Name: "user_status", Values: []string{"active", "inactive"}},
+	})
+	cfg := config.ConfigMock()
+
+	cfg.Templates = []config.TemplateConfig{
+		{Path: "./golden_testing/templates/04_template.go.tmpl", Output: "./golden_testing/got/04_template.go"},
+		{Path: "./golden_testing/templates/04_template.md.tmpl", Output: "./golden_testing/got/04_template.md"},
+	}
+	cfg.Tags = []config.TagConfig{{Key: "db"}}
+
+	gen := generator.New(&cfg, postgres.DefaultMappers(), mockLdr)
+	if err := gen.Run(context.Background()); err != nil {
+		t.Fatalf("failed to generate files: %v", err)
+	}
+
+	t.Run("assert generated code is correct", func(t *testing.T) {
+		assertGoldenFile(t, "04_template.go", "04_template.go")
+	})
+
+	t.Run("assert generated document is correct", func(t *testing.T) {
+		assertGoldenFile(t, "04_template.md", "04_template.md")
+	})
+
+	t.Run("built-in output is not written", func(t *testing.T) {
+		_, err := os.Stat(cfg.Output)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestRun_Templates_Error(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{Name: "users", Columns: []generator.Column{{Name: "id", Type: "integer", OrderAsc: 1}}},
+	})
+
+	tests := []struct {
+		name     string
+		template string
+		wantErr  string
+	}{
+		{name: "unknown field", template: "{{.Unknown}}", wantErr: "failed to execute template"},
+		{name: "invalid go", template: "package {{.PkgName}}\n\nfunc {", wantErr: "failed to format the output of template"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "tmpl.go.tmpl")
+			if err := os.WriteFile(path, []byte(tt.template), 0644); err != nil {
+				t.Fatal(err)
 			}
 
-			want, err := os.ReadFile("./golden_testing/want/" + wantFileName)
-			if err != nil {
-				t.Fatalf("failed to read file: %v", err)
+			cfg := config.ConfigMock()
+			cfg.Templates = []config.TemplateConfig{{Path: path, Output: filepath.Join(dir, "out.go")}}
+
+			err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background())
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRun_OutputDir(t *testing.T) {
+	users := generator.Table{
+		Name: "users",
+		Columns: []generator.Column{
+			{Name: "id", Type: "integer", OrderAsc: 1},
+			{Name: "status", Type: "USER-DEFINED", Enum: "user_status", OrderAsc: 2},
+		},
+		PrimaryKey: []string{"id"},
+	}
+	posts := generator.Table{
+		Name:       "posts",
+		Columns:    []generator.Column{{Name: "id", Type: "integer", OrderAsc: 1}},
+		PrimaryKey: []string{"id"},
+	}
+	enums := []generator.Enum{{Name: "user_status", Values: []string{"active"}}}
+
+	dir := t.TempDir()
+	cfg := config.ConfigMock()
+	cfg.OutputDir = dir
+	cfg.FileNamePattern = "{{ToSnake .ModelName}}.gen.go"
+
+	// a file not generated by chair must be kept
+	if err := os.WriteFile(filepath.Join(dir, "user_methods.go"), []byte("package pkgname\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(t *testing.T, tables ...generator.Table) {
+		t.Helper()
+		mockLdr := generator.SchemaLoaderMock{}.WithTable(tables).WithEnums(enums)
+		if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go files: %v", err)
+		}
+	}
+
+	readDir := func(t *testing.T) []string {
+		t.Helper()
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+
+		return names
+	}
+
+	t.Run("one file per table", func(t *testing.T) {
+		run(t, users, posts)
+
+		assert.Equal(t, []string{".chair-manifest", "enums_gen.go", "post.gen.go", "user.gen.go", "user_methods.go"}, readDir(t))
+
+		got, err := os.ReadFile(filepath.Join(dir, "user.gen.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Contains(t, string(got), "type User struct")
+		assert.NotContains(t, string(got), "type Post struct")
+
+		manifest, err := os.ReadFile(filepath.Join(dir, ".chair-manifest"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Contains(t, string(manifest), "enums_gen.go\npost.gen.go\nuser.gen.go\n")
+	})
+
+	t.Run("files of dropped tables are deleted", func(t *testing.T) {
+		run(t, users)
+
+		assert.Equal(t, []string{".chair-manifest", "enums_gen.go", "user.gen.go", "user_methods.go"}, readDir(t))
+	})
+
+	t.Run("invalid file name", func(t *testing.T) {
+		cfg.FileNamePattern = "{{.Name}}/model.go"
+		mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{users})
+
+		err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background())
+		assert.ErrorContains(t, err, "file name must be a .go file in the output directory")
+	})
+
+	t.Run("tables of the same name in different schemas", func(t *testing.T) {
+		publicUsers, billingUsers := posts, posts
+		publicUsers.Schema, publicUsers.Name = "public", "users"
+		billingUsers.Schema, billingUsers.Name = "billing", "users"
+		mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{publicUsers, billingUsers})
+
+		cfg := config.ConfigMock()
+		cfg.OutputDir = t.TempDir()
+		if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go files: %v", err)
+		}
+
+		got, err := os.ReadFile(filepath.Join(cfg.OutputDir, "billing_users_gen.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Contains(t, string(got), "type BillingUser struct")
+		assert.FileExists(t, filepath.Join(cfg.OutputDir, "public_users_gen.go"))
+	})
+}
+
+func TestCheck(t *testing.T) {
+	users := generator.Table{
+		Name:       "users",
+		Columns:    []generator.Column{{Name: "id", Type: "integer", OrderAsc: 1}},
+		PrimaryKey: []string{"id"},
+	}
+	posts := generator.Table{
+		Name:    "posts",
+		Columns: []generator.Column{{Name: "id", Type: "integer", OrderAsc: 1}},
+	}
+
+	t.Run("output file", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+		gen := generator.New(&cfg, postgres.DefaultMappers(), generator.SchemaLoaderMock{}.WithTable([]generator.Table{users}))
+
+		var diff bytes.Buffer
+		err := gen.Check(context.Background(), &diff)
+		assert.ErrorIs(t, err, generator.ErrOutOfDate)
+		assert.Contains(t, diff.String(), "--- /dev/null\n+++ b/"+cfg.Output+"\n")
+		_, err = os.Stat(cfg.Output)
+		assert.True(t, os.IsNotExist(err), "check must not write the output")
+
+		if err := gen.Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		diff.Reset()
+		assert.NoError(t, gen.Check(context.Background(), &diff))
+		assert.Empty(t, diff.String())
+
+		gen = generator.New(&cfg, postgres.DefaultMappers(), generator.SchemaLoaderMock{}.WithTable([]generator.Table{users, posts}))
+		err = gen.Check(context.Background(), &diff)
+		assert.ErrorIs(t, err, generator.ErrOutOfDate)
+		assert.Contains(t, diff.String(), "--- a/"+cfg.Output+"\n+++ b/"+cfg.Output+"\n")
+		assert.Contains(t, diff.String(), "+type Post struct {\n")
+	})
+
+	t.Run("stale files of output directory", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.OutputDir = t.TempDir()
+		if err := generator.New(&cfg, postgres.DefaultMappers(), generator.SchemaLoaderMock{}.WithTable([]generator.Table{users, posts})).Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go files: %v", err)
+		}
+
+		var diff bytes.Buffer
+		gen := generator.New(&cfg, postgres.DefaultMappers(), generator.SchemaLoaderMock{}.WithTable([]generator.Table{users}))
+		err := gen.Check(context.Background(), &diff)
+		assert.ErrorIs(t, err, generator.ErrOutOfDate)
+
+		postsFile := filepath.Join(cfg.OutputDir, "posts_gen.go")
+		assert.Contains(t, diff.String(), "--- a/"+postsFile+"\n+++ /dev/null\n")
+		assert.FileExists(t, postsFile)
+	})
+}
+
+type mysqlSchemaLoaderMock struct {
+	generator.SchemaLoaderMock
+}
+
+func (mysqlSchemaLoaderMock) Dialect() generator.Dialect {
+	return generator.DialectMySQL
+}
+
+func TestRun_Repository(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "users",
+			Columns: []generator.Column{
+				{Name: "id", Type: "uuid", OrderAsc: 1},
+				{Name: "name", Type: "text", OrderAsc: 2},
+				{Name: "email", Type: "text", IsNullable: true, OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Name: "user_roles",
+			Columns: []generator.Column{
+				{Name: "user_id", Type: "uuid", OrderAsc: 1},
+				{Name: "type", Type: "text", OrderAsc: 2},
+			},
+			PrimaryKey: []string{"type", "user_id"},
+		},
+		{
+			Name: "audit_logs",
+			Columns: []generator.Column{
+				{Name: "message", Type: "text", OrderAsc: 1},
+			},
+		},
+		{
+			Name: "active_users",
+			Kind: generator.TableKindView,
+			Columns: []generator.Column{
+				{Name: "id", Type: "uuid", OrderAsc: 1},
+			},
+		},
+	})
+
+	tests := []struct {
+		name         string
+		schemaLoader generator.SchemaLoader
+		fileName     string
+	}{
+		{name: "PostgreSQL", schemaLoader: mockLdr, fileName: "05_repository.go"},
+		{name: "MySQL", schemaLoader: mysqlSchemaLoaderMock{mockLdr}, fileName: "06_repository_mysql.go"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.ConfigMock()
+			cfg.Output = "./golden_testing/got/" + tt.fileName
+			cfg.EmitRepository = true
+
+			gen := generator.New(&cfg, postgres.DefaultMappers(), tt.schemaLoader)
+			if err := gen.Run(context.Background()); err != nil {
+				t.Fatalf("failed to generate go file: %v", err)
 			}
 
-			return assert.Equal(t, string(want), string(got))
+			assertGoldenFile(t, tt.fileName, tt.fileName)
+		})
+	}
+}
+
+func TestRun_MetadataMethodsCollidingWithFields(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "audit_entries",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "table_name", Type: "text", OrderAsc: 2},
+				{Name: "columns", Type: "text", OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+		},
+	})
+	cfg := config.ConfigMock()
+	cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+
+	if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+		t.Fatalf("failed to generate go file: %v", err)
+	}
+
+	got, err := os.ReadFile(cfg.Output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NotContains(t, string(got), "func (AuditEntry) TableName() string")
+	assert.NotContains(t, string(got), "func (AuditEntry) Columns() []string")
+	assert.Contains(t, string(got), "func (m *AuditEntry) ScanDest() []any")
+	assert.Contains(t, string(got), "TableName AuditEntryColumn")
+}
+
+func TestRun_NullStyle(t *testing.T) {
+	columns := []generator.Column{
+		{Name: "id", Type: "integer", OrderAsc: 1},
+		{Name: "name", Type: "text", IsNullable: true, OrderAsc: 2},
+		{Name: "age", Type: "integer", IsNullable: true, OrderAsc: 3},
+		{Name: "score", Type: "bigint", IsNullable: true, OrderAsc: 4},
+		{Name: "born_on", Type: "date", IsNullable: true, OrderAsc: 5},
+		{Name: "deleted_at", Type: "timestamp without time zone", IsNullable: true, OrderAsc: 6},
+		{Name: "token", Type: "uuid", IsNullable: true, OrderAsc: 7},
+		{Name: "avatar", Type: "bytea", IsNullable: true, OrderAsc: 8},
+		{Name: "status", Type: "USER-DEFINED", Enum: "status", IsNullable: true, OrderAsc: 9},
+	}
+	var tables []generator.Table
+	for _, name := range []string{"sql_items", "pointer_items", "generic_items", "guregu_items", "pgtype_items"} {
+		tables = append(tables, generator.Table{Schema: "public", Name: name, Columns: columns})
+	}
+	mockLdr := generator.SchemaLoaderMock{}.WithTable(tables).WithEnums([]generator.Enum{
+		{Schema: "public", Name: "status", Values: []string{"active"}},
+	})
+
+	cfg := config.ConfigMock()
+	cfg.Output = "./golden_testing/got/07_null_style.go"
+	cfg.NullStyle = config.NullStyleSQL
+	cfg.Overrides = map[string]config.Override{
+		"pointer_items":        {NullStyle: config.NullStylePointer},
+		"public.generic_items": {NullStyle: config.NullStyleGeneric},
+		"guregu_items":         {NullStyle: config.NullStyleGuregu},
+		"pgtype_items":         {NullStyle: config.NullStylePgtype},
+	}
+
+	gen := generator.New(&cfg, postgres.DefaultMappers(), mockLdr)
+	if err := gen.Run(context.Background()); err != nil {
+		t.Fatalf("failed to generate go file: %v", err)
+	}
+
+	t.Run("assert generated code is correct", func(t *testing.T) {
+		assertGoldenFile(t, filepath.Base(cfg.Output), "07_null_style.go")
+	})
+}
+
+func TestRun_PgxMappingProfile(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "payments",
+			Columns: []generator.Column{
+				{Name: "id", Type: "uuid", OrderAsc: 1},
+				{Name: "seq", Type: "bigint", OrderAsc: 2},
+				{Name: "amount", Type: "numeric", OrderAsc: 3},
+				{Name: "fee", Type: "money", IsNullable: true, OrderAsc: 4},
+				{Name: "retry_after", Type: "interval", IsNullable: true, OrderAsc: 5},
+				{Name: "client_ip", Type: "inet", IsNullable: true, OrderAsc: 6},
+				{Name: "attempts", Type: "integer", IsNullable: true, OrderAsc: 7},
+				{Name: "memo", Type: "text", IsNullable: true, OrderAsc: 8},
+				{Name: "payload", Type: "jsonb", IsNullable: true, OrderAsc: 9},
+				{Name: "paid_at", Type: "timestamp with time zone", IsNullable: true, OrderAsc: 10},
+				{Name: "valid_during", Type: "tstzrange", OrderAsc: 11},
+				{Name: "tags", Type: "ARRAY", ElemType: "text", ArrayDims: 1, IsNullable: true, OrderAsc: 12},
+			},
+			PrimaryKey: []string{"id"},
+		},
+	})
+	cfg := config.ConfigMock()
+	cfg.Output = "./golden_testing/got/08_pgx.go"
+	cfg.Mappings = nil
+	cfg.Postgres.MappingProfile = config.MappingProfilePgx
+
+	gen := generator.New(&cfg, postgres.Mappers(config.MappingProfilePgx), mockLdr)
+	if err := gen.Run(context.Background()); err != nil {
+		t.Fatalf("failed to generate go file: %v", err)
+	}
+
+	t.Run("assert generated code is correct", func(t *testing.T) {
+		assertGoldenFile(t, filepath.Base(cfg.Output), "08_pgx.go")
+	})
+}
+
+func TestRun_BuiltinTypeMappings(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "documents",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "body", Type: "jsonb", OrderAsc: 2},
+				{Name: "extra", Type: "json", IsNullable: true, OrderAsc: 3},
+				{Name: "thumbnail", Type: "bytea", IsNullable: true, OrderAsc: 4},
+				{Name: "retry_after", Type: "interval", IsNullable: true, OrderAsc: 5},
+				{Name: "client_ip", Type: "inet", OrderAsc: 6},
+				{Name: "flags", Type: "bit varying", OrderAsc: 7},
+				{Name: "source", Type: "xml", IsNullable: true, OrderAsc: 8},
+				{Name: "search", Type: "tsvector", OrderAsc: 9},
+				{Name: "location", Type: "point", IsNullable: true, OrderAsc: 10},
+				{Name: "pages", Type: "int4range", OrderAsc: 11},
+			},
+			PrimaryKey: []string{"id"},
+		},
+	})
+	cfg := config.ConfigMock()
+	cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+
+	if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+		t.Fatalf("failed to generate go file: %v", err)
+	}
+
+	got, err := os.ReadFile(cfg.Output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NotContains(t, string(got), "interface{}")
+	assert.Contains(t, string(got), "Body json.RawMessage")
+	assert.Contains(t, string(got), "Extra *json.RawMessage")
+	assert.Contains(t, string(got), "Thumbnail []byte")
+	assert.Contains(t, string(got), "RetryAfter sql.NullString")
+	assert.Contains(t, string(got), "Pages string")
+}
+
+func TestRun_ArrayTypes(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "events",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "tags", Type: "ARRAY", ElemType: "text", ArrayDims: 1, OrderAsc: 2},
+				{Name: "user_ids", Type: "ARRAY", ElemType: "uuid", ArrayDims: 1, OrderAsc: 3},
+				{Name: "occurred_at", Type: "ARRAY", ElemType: "timestamp with time zone", ArrayDims: 1, OrderAsc: 4},
+			},
+			PrimaryKey: []string{"id"},
+		},
+	})
+
+	generate := func(t *testing.T, cfg config.Config) (string, error) {
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+		cfg.UnmappedTypes = config.UnmappedTypesError
+
+		if err := generator.New(&cfg, postgres.Mappers(cfg.Postgres.MappingProfile), mockLdr).Run(context.Background()); err != nil {
+			return "", err
 		}
 
-		assertGoldenFile(t, filepath.Base(cfg.Output), "01_output.go")
+		got, err := os.ReadFile(cfg.Output)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(got), nil
+	}
+
+	t.Run("arrays database/sql cannot scan are unmapped", func(t *testing.T) {
+		_, err := generate(t, config.ConfigMock())
+		if assert.Error(t, err) {
+			// uuid is mapped to uuid.UUID by the config, so pq.StringArray is not used for uuid[]
+			assert.Contains(t, err.Error(), "events.user_ids (uuid[])")
+			assert.Contains(t, err.Error(), "events.occurred_at (timestamp with time zone[])")
+			assert.NotContains(t, err.Error(), "events.tags")
+		}
+	})
+
+	t.Run("array mappings of the config are used", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.Mappings = append(cfg.Mappings,
+			config.TypeMapping{DBType: "uuid", GoType: "UUIDs", GoPkg: "example.com/types", IsArray: true},
+			config.TypeMapping{DBType: "timestamp with time zone", GoType: "TimeArray", GoPkg: "example.com/types", IsArray: true},
+		)
+
+		got, err := generate(t, cfg)
+		if err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+		assert.Contains(t, got, "Tags pq.StringArray")
+		assert.Contains(t, got, "UserIds types.UUIDs")
+		assert.Contains(t, got, "OccurredAt types.TimeArray")
+	})
+
+	t.Run("pgx scans arrays into slices", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.Postgres.MappingProfile = config.MappingProfilePgx
+
+		got, err := generate(t, cfg)
+		if err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+		assert.Contains(t, got, "UserIds []uuid.UUID")
+		assert.Contains(t, got, "OccurredAt []pgtype.Timestamptz")
+	})
+}
+
+func TestRun_UnmappedTypes(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Schema: "public",
+			Name:   "places",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "location", Type: "geography", OrderAsc: 2},
+				{Name: "areas", Type: "ARRAY", ElemType: "geometry", ArrayDims: 1, IsNullable: true, OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+		},
+	})
+
+	t.Run("error lists the unmapped columns", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+		cfg.UnmappedTypes = config.UnmappedTypesError
+
+		err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background())
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "public.places.location (geography)")
+			assert.Contains(t, err.Error(), "public.places.areas (geometry[])")
+		}
+		assert.NoFileExists(t, cfg.Output)
+	})
+
+	t.Run("fallback type is used for the unmapped columns", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+		cfg.UnmappedTypes = config.UnmappedTypesAllow
+		cfg.FallbackType = config.FallbackType{GoType: "RawMessage", GoPkg: "encoding/json"}
+
+		if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		got, err := os.ReadFile(cfg.Output)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Contains(t, string(got), "Location json.RawMessage")
+		assert.Contains(t, string(got), "Areas json.RawMessage")
+	})
+}
+
+func TestRun_Overrides(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "users",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "metadata", Type: "jsonb", OrderAsc: 2},
+				{Name: "password_hash", Type: "text", OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Name: "orders",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "user_id", Type: "integer", OrderAsc: 2},
+				{Name: "qty", Type: "integer", OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "orders_user_id_fkey", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+			},
+		},
+		{
+			Name: "legacy_logs",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+			},
+		},
+	})
+	cfg := config.ConfigMock()
+	cfg.Output = "./golden_testing/got/09_overrides.go"
+	cfg.EmitRelations = true
+	cfg.Tags = []config.TagConfig{{Key: "json"}}
+	cfg.Overrides = map[string]config.Override{
+		"users":               {StructName: "Account", Comment: "accounts of the service"},
+		"users.metadata":      {GoType: "UserMetadata", Comment: "typed jsonb"},
+		"users.password_hash": {Skip: true},
+		"orders.user_id":      {FieldName: "AccountID"},
+		"orders.qty":          {FieldName: "Quantity", Tags: map[string]string{"json": "quantity", "validate": "min=1"}},
+		"legacy_logs":         {Skip: true},
+	}
+
+	gen := generator.New(&cfg, postgres.DefaultMappers(), mockLdr)
+	if err := gen.Run(context.Background()); err != nil {
+		t.Fatalf("failed to generate go file: %v", err)
+	}
+
+	t.Run("assert generated code is correct", func(t *testing.T) {
+		assertGoldenFile(t, filepath.Base(cfg.Output), "09_overrides.go")
+	})
+
+	t.Run("primary key columns cannot be skipped", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+		cfg.Overrides = map[string]config.Override{"users.id": {Skip: true}}
+
+		err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background())
+		assert.ErrorContains(t, err, "primary key columns cannot be skipped")
+	})
+
+	t.Run("keys and relations on a skipped column are removed", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+		cfg.EmitRelations = true
+		cfg.Overrides = map[string]config.Override{"orders.user_id": {Skip: true}}
+
+		if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		got, err := os.ReadFile(cfg.Output)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NotContains(t, string(got), "orders_user_id_fkey")
+		assert.NotContains(t, string(got), "User *User")
+		assert.NotContains(t, string(got), "Orders []*Order")
+	})
+}
+
+func TestRun_Filters(t *testing.T) {
+	table := func(name string, columns ...string) generator.Table {
+		tbl := generator.Table{Name: name, PrimaryKey: []string{"id"}}
+		for i, column := range append([]string{"id"}, columns...) {
+			tbl.Columns = append(tbl.Columns, generator.Column{Name: column, Type: "integer", OrderAsc: i + 1})
+		}
+
+		return tbl
+	}
+	users := table("users", "age", "deleted_at")
+	users.Indexes = []generator.Index{{Name: "users_age_idx", Columns: []string{"age"}}}
+	posts := table("posts", "user_id", "deleted_at")
+	posts.ForeignKeys = []generator.ForeignKey{
+		{Name: "posts_user_id_fkey", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+	}
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		table("schema_migrations"),
+		table("goose_db_version"),
+		table("events", "user_id"),
+		table("events_p2024", "user_id"),
+		users,
+		posts,
+	})
+	generate := func(t *testing.T, include, exclude config.FilterConfig) (string, error) {
+		cfg := config.ConfigMock()
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+		cfg.EmitRelations = true
+		cfg.Include = include
+		cfg.Exclude = exclude
+
+		if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+			return "", err
+		}
+
+		got, err := os.ReadFile(cfg.Output)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(got), nil
+	}
+
+	t.Run("exclude tables and columns by glob and regex", func(t *testing.T) {
+		got, err := generate(t, config.FilterConfig{}, config.FilterConfig{
+			Tables:  []string{"schema_migrations", "goose_*", `/^events_p\d+$/`},
+			Columns: []string{"*.deleted_at"},
+		})
+		if err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		assert.NotContains(t, got, "type SchemaMigration struct")
+		assert.NotContains(t, got, "type GooseDbVersion struct")
+		assert.NotContains(t, got, "type EventsP2024 struct")
+		assert.NotContains(t, got, "DeletedAt")
+		assert.Contains(t, got, "type Event struct")
+		assert.Contains(t, got, "type User struct")
+		assert.Contains(t, got, "type Post struct")
+	})
+
+	t.Run("include limits tables and columns except the primary key", func(t *testing.T) {
+		got, err := generate(t, config.FilterConfig{
+			Tables:  []string{"users", "posts"},
+			Columns: []string{"users.*", "posts.user_id"},
+		}, config.FilterConfig{})
+		if err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		assert.Contains(t, got, "// users.deleted_at")
+		assert.Contains(t, got, "// posts.id")
+		assert.Contains(t, got, "// posts.user_id")
+		assert.NotContains(t, got, "// posts.deleted_at")
+		assert.NotContains(t, got, "type Event struct")
+	})
+
+	t.Run("primary key columns cannot be excluded", func(t *testing.T) {
+		_, err := generate(t, config.FilterConfig{}, config.FilterConfig{Columns: []string{"users.*"}})
+		assert.ErrorContains(t, err, "primary key columns cannot be excluded")
+	})
+
+	t.Run("relations to a filtered table are removed", func(t *testing.T) {
+		got, err := generate(t, config.FilterConfig{}, config.FilterConfig{Tables: []string{"users"}})
+		if err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		assert.Contains(t, got, "type Post struct")
+		assert.NotContains(t, got, "User *User")
+	})
+
+	t.Run("keys and relations on a filtered column are removed", func(t *testing.T) {
+		got, err := generate(t, config.FilterConfig{}, config.FilterConfig{Columns: []string{"posts.user_id", "users.age"}})
+		if err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		assert.NotContains(t, got, "users_age_idx")
+		assert.NotContains(t, got, "User *User")
+		assert.NotContains(t, got, "Posts []*Post")
+	})
+
+	t.Run("keys and relations on kept columns are kept", func(t *testing.T) {
+		got, err := generate(t, config.FilterConfig{}, config.FilterConfig{})
+		if err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		assert.Contains(t, got, "index: users_age_idx (age)")
+		assert.Contains(t, got, "User *User")
+		assert.Contains(t, got, "Posts []*Post")
+	})
+}
+
+func TestRun_MultiSchema(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Schema: "auth",
+			Name:   "users",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "status", Type: "USER-DEFINED", Enum: "status", OrderAsc: 2},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Schema: "billing",
+			Name:   "invoices",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "status", Type: "USER-DEFINED", Enum: "status", OrderAsc: 2},
+				{Name: "amount", Type: "numeric", OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Schema: "billing",
+			Name:   "users",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+			},
+			PrimaryKey: []string{"id"},
+		},
+	}).WithEnums([]generator.Enum{
+		{Schema: "auth", Name: "status", Values: []string{"active", "locked"}},
+		{Schema: "billing", Name: "status", Values: []string{"draft", "paid"}},
+	})
+
+	t.Run("prefix layout", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.Output = "./golden_testing/got/10_multi_schema.go"
+		cfg.EmitRepository = true
+		cfg.Overrides = map[string]config.Override{
+			"billing.users": {StructName: "Customer"},
+		}
+		cfg.Exclude = config.FilterConfig{Columns: []string{"billing.invoices.amount"}}
+
+		gen := generator.New(&cfg, postgres.DefaultMappers(), mockLdr)
+		if err := gen.Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		assertGoldenFile(t, filepath.Base(cfg.Output), "10_multi_schema.go")
+	})
+
+	t.Run("package layout", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := config.ConfigMock()
+		cfg.Output = filepath.Join(dir, "model_gen.go")
+		cfg.SchemaLayout = config.SchemaLayoutPackage
+
+		if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go files: %v", err)
+		}
+
+		auth, err := os.ReadFile(filepath.Join(dir, "auth", "model_gen.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Contains(t, string(auth), "package auth\n")
+		assert.Contains(t, string(auth), "type User struct")
+		assert.Contains(t, string(auth), "StatusActive")
+		assert.NotContains(t, string(auth), "type Invoice struct")
+
+		billing, err := os.ReadFile(filepath.Join(dir, "billing", "model_gen.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Contains(t, string(billing), "package billing\n")
+		assert.Contains(t, string(billing), "type Invoice struct")
+		assert.Contains(t, string(billing), "type User struct")
+		assert.Contains(t, string(billing), "StatusPaid")
+
+		assert.NoFileExists(t, cfg.Output)
+	})
+
+	t.Run("single schema other than public", func(t *testing.T) {
+		mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+			{
+				Schema:     "billing",
+				Name:       "invoices",
+				Columns:    []generator.Column{{Name: "id", Type: "integer", OrderAsc: 1}},
+				PrimaryKey: []string{"id"},
+			},
+		})
+
+		cfg := config.ConfigMock()
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+		cfg.EmitRepository = true
+
+		if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		got, err := os.ReadFile(cfg.Output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Contains(t, string(got), "type Invoice struct")
+		assert.Contains(t, string(got), `return "billing.invoices"`)
+		assert.Contains(t, string(got), `DELETE FROM "billing"."invoices" WHERE "id" = $1`)
+	})
+
+	t.Run("enum of another schema", func(t *testing.T) {
+		mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+			{
+				Schema: "auth",
+				Name:   "users",
+				Columns: []generator.Column{
+					{Name: "id", Type: "integer", OrderAsc: 1},
+					{Name: "status", Type: "USER-DEFINED", Enum: "status", EnumSchema: "auth", OrderAsc: 2},
+				},
+				PrimaryKey: []string{"id"},
+			},
+			{
+				Schema: "billing",
+				Name:   "invoices",
+				Columns: []generator.Column{
+					{Name: "id", Type: "integer", OrderAsc: 1},
+					{Name: "user_status", Type: "USER-DEFINED", Enum: "status", EnumSchema: "auth", OrderAsc: 2},
+				},
+				PrimaryKey: []string{"id"},
+			},
+		}).WithEnums([]generator.Enum{
+			{Schema: "auth", Name: "status", Values: []string{"active", "locked"}},
+			{Schema: "billing", Name: "status", Values: []string{"draft", "paid"}},
+		})
+
+		cfg := config.ConfigMock()
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+
+		if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		got, err := os.ReadFile(cfg.Output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Contains(t, string(got), "UserStatus AuthStatus\n")
+		assert.NotContains(t, string(got), "UserStatus BillingStatus")
+	})
+
+	t.Run("relations across schemas", func(t *testing.T) {
+		mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+			{
+				Schema:     "auth",
+				Name:       "users",
+				Columns:    []generator.Column{{Name: "id", Type: "integer", OrderAsc: 1}},
+				PrimaryKey: []string{"id"},
+			},
+			{
+				Schema: "billing",
+				Name:   "invoices",
+				Columns: []generator.Column{
+					{Name: "id", Type: "integer", OrderAsc: 1},
+					{Name: "user_id", Type: "integer", OrderAsc: 2},
+				},
+				PrimaryKey: []string{"id"},
+				ForeignKeys: []generator.ForeignKey{
+					{Name: "invoices_user_id_fkey", Columns: []string{"user_id"}, RefSchema: "auth", RefTable: "users", RefColumns: []string{"id"}},
+				},
+			},
+		})
+
+		for _, layout := range []string{config.SchemaLayoutPrefix, config.SchemaLayoutPackage} {
+			t.Run(layout, func(t *testing.T) {
+				dir := t.TempDir()
+				cfg := config.ConfigMock()
+				cfg.Output = filepath.Join(dir, "model_gen.go")
+				cfg.SchemaLayout = layout
+				cfg.EmitRelations = true
+
+				if err := generator.New(&cfg, postgres.DefaultMappers(), mockLdr).Run(context.Background()); err != nil {
+					t.Fatalf("failed to generate go files: %v", err)
+				}
+
+				if layout == config.SchemaLayoutPrefix {
+					got, err := os.ReadFile(cfg.Output)
+					if err != nil {
+						t.Fatal(err)
+					}
+					assert.Contains(t, string(got), "User *AuthUser")
+					assert.Contains(t, string(got), "Invoices []*BillingInvoice")
+
+					return
+				}
+
+				// another package cannot be referenced, so the relations are omitted
+				billing, err := os.ReadFile(filepath.Join(dir, "billing", "model_gen.go"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.NotContains(t, string(billing), "User *")
+			})
+		}
+	})
+}
+
+func TestRun_ColumnDefaults(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "orders",
+			Columns: []generator.Column{
+				{Name: "id", Type: "bigint", OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationAlways},
+				{Name: "price", Type: "integer", OrderAsc: 2},
+				{Name: "qty", Type: "integer", OrderAsc: 3, Default: "1"},
+				{Name: "total", Type: "integer", OrderAsc: 4, IsGenerated: true},
+				{Name: "created_at", Type: "timestamp with time zone", OrderAsc: 5, Default: "now()"},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Name: "counters",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1, Default: "nextval('counters_id_seq'::regclass)"},
+			},
+			PrimaryKey: []string{"id"},
+		},
+	})
+
+	t.Run("assert generated code is correct", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.Output = "./golden_testing/got/11_column_defaults.go"
+		cfg.EmitRepository = true
+		cfg.Tags = []config.TagConfig{{Key: "gorm"}, {Key: "bun"}}
+
+		gen := generator.New(&cfg, postgres.DefaultMappers(), mockLdr)
+		if err := gen.Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		assertGoldenFile(t, filepath.Base(cfg.Output), "11_column_defaults.go")
+	})
+
+	generate := func(t *testing.T, cfg config.Config, ldr generator.SchemaLoader) string {
+		cfg.Output = filepath.Join(t.TempDir(), "model_gen.go")
+		cfg.EmitRepository = true
+
+		if err := generator.New(&cfg, postgres.DefaultMappers(), ldr).Run(context.Background()); err != nil {
+			t.Fatalf("failed to generate go file: %v", err)
+		}
+
+		got, err := os.ReadFile(cfg.Output)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(got)
+	}
+
+	t.Run("omit defaults on insert", func(t *testing.T) {
+		cfg := config.ConfigMock()
+		cfg.OmitDefaultsOnInsert = true
+
+		got := generate(t, cfg, mockLdr)
+		assert.Contains(t, got, `INSERT INTO "orders" ("price") VALUES ($1) RETURNING "id", "qty", "total", "created_at"`)
+		assert.Contains(t, got, `INSERT INTO "orders" ("price") VALUES `)
+	})
+
+	t.Run("MySQL has no RETURNING", func(t *testing.T) {
+		got := generate(t, config.ConfigMock(), mysqlSchemaLoaderMock{mockLdr})
+		assert.Contains(t, got, "res, err := r.db.ExecContext(ctx, \"INSERT INTO `orders` (`price`, `qty`, `created_at`) VALUES (?, ?, ?)\", m.Price, m.Qty, m.CreatedAt)")
+		assert.Contains(t, got, "INSERT INTO `counters` () VALUES ()")
+		assert.NotContains(t, got, "RETURNING")
+	})
+
+	t.Run("MySQL reads the AUTO_INCREMENT value back", func(t *testing.T) {
+		got := generate(t, config.ConfigMock(), mysqlSchemaLoaderMock{mockLdr})
+		assert.Contains(t, got, "lastID, err := res.LastInsertId()")
+		assert.Contains(t, got, "m.ID = lastID\n")
+	})
+}
+
+func TestRun_ColumnSizes(t *testing.T) {
+	mockLdr := generator.SchemaLoaderMock{}.WithTable([]generator.Table{
+		{
+			Name: "products",
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", OrderAsc: 1},
+				{Name: "grade", Type: "character", OrderAsc: 2, Length: 1},
+				{Name: "sku", Type: "character", OrderAsc: 3, Length: 8},
+				{Name: "name", Type: "character varying", IsNullable: true, OrderAsc: 4, Length: 255},
+				{Name: "description", Type: "text", OrderAsc: 5},
+				{Name: "price", Type: "numeric", OrderAsc: 6, Precision: 12, Scale: 2},
+				{Name: "stock", Type: "numeric", OrderAsc: 7, Precision: 10},
+				{Name: "weight", Type: "numeric", OrderAsc: 8},
+				{Name: "released_at", Type: "timestamp with time zone", OrderAsc: 9, DatetimePrecision: 3},
+			},
+			PrimaryKey: []string{"id"},
+		},
+	})
+
+	scale := 0
+	cfg := config.ConfigMock()
+	cfg.Output = "./golden_testing/got/12_column_sizes.go"
+	cfg.Tags = []config.TagConfig{{Key: "db"}, {Key: "validate"}}
+	cfg.Mappings = []config.TypeMapping{
+		{DBType: "character", GoType: "rune", Length: 1},
+		{DBType: "numeric", GoType: "int64", Scale: &scale},
+	}
+
+	gen := generator.New(&cfg, postgres.DefaultMappers(), mockLdr)
+	if err := gen.Run(context.Background()); err != nil {
+		t.Fatalf("failed to generate go file: %v", err)
+	}
+
+	t.Run("assert generated code is correct", func(t *testing.T) {
+		assertGoldenFile(t, filepath.Base(cfg.Output), "12_column_sizes.go")
 	})
 }
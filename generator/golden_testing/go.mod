@@ -5,4 +5,6 @@ go 1.22.0
 require (
 	github.com/google/uuid v1.6.0
 	github.com/guregu/null v4.0.0+incompatible
+	github.com/jackc/pgx/v5 v5.7.4
+	github.com/lib/pq v1.10.9
 )
@@ -2,14 +2,74 @@ package pkgname
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	uuid "github.com/google/uuid"
 	null "github.com/guregu/null"
+	pq "github.com/lib/pq"
 	"time"
 )
 
 // Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
 
+// mood: mood of a person
+type Mood string
+
+const (
+	MoodSad   Mood = "sad"
+	MoodOk    Mood = "ok"
+	MoodHappy Mood = "happy"
+)
+
+// Values returns all values of Mood in the order of the definition.
+func (Mood) Values() []Mood {
+	return []Mood{MoodSad, MoodOk, MoodHappy}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Mood) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of Mood.
+func (e Mood) IsValid() bool {
+	switch e {
+	case MoodSad, MoodOk, MoodHappy:
+		return true
+	}
+	return false
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *Mood) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		*e = Mood(v)
+	case []byte:
+		*e = Mood(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Mood", src)
+	}
+
+	if !e.IsValid() {
+		return fmt.Errorf("invalid Mood: %q", *e)
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (e Mood) Value() (driver.Value, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid Mood: %q", e)
+	}
+
+	return string(e), nil
+}
+
 // character_types
+//
+// primary key: (id)
 type CharacterType struct {
 	// character_types.id
 	ID int
@@ -33,7 +93,43 @@ type CharacterType struct {
 	TextValue string
 }
 
+// PrimaryKey returns the column names of the primary key of character_types.
+func (CharacterType) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// CharacterTypeColumn is a column name of character_types.
+type CharacterTypeColumn string
+
+// Column names of character_types.
+const (
+	CharacterTypeColumnID                            CharacterTypeColumn = "id"
+	CharacterTypeColumnCharacterValueNullable        CharacterTypeColumn = "character_value_nullable"
+	CharacterTypeColumnCharacterVaryingValueNullable CharacterTypeColumn = "character_varying_value_nullable"
+	CharacterTypeColumnTextValueNullable             CharacterTypeColumn = "text_value_nullable"
+	CharacterTypeColumnCharacterValue                CharacterTypeColumn = "character_value"
+	CharacterTypeColumnCharacterVaryingValue         CharacterTypeColumn = "character_varying_value"
+	CharacterTypeColumnTextValue                     CharacterTypeColumn = "text_value"
+)
+
+// TableName returns the name of the table of CharacterType.
+func (CharacterType) TableName() string {
+	return "character_types"
+}
+
+// Columns returns the column names of character_types in the order of the fields.
+func (CharacterType) Columns() []string {
+	return []string{"id", "character_value_nullable", "character_varying_value_nullable", "text_value_nullable", "character_value", "character_varying_value", "text_value"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *CharacterType) ScanDest() []any {
+	return []any{&m.ID, &m.CharacterValueNullable, &m.CharacterVaryingValueNullable, &m.TextValueNullable, &m.CharacterValue, &m.CharacterVaryingValue, &m.TextValue}
+}
+
 // numeric_types: numeric types
+//
+// primary key: (id)
 type NumericType struct {
 	// numeric_types.id
 	ID int
@@ -90,7 +186,54 @@ type NumericType struct {
 	BigserialValue int64
 }
 
+// PrimaryKey returns the column names of the primary key of numeric_types.
+func (NumericType) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// NumericTypeColumn is a column name of numeric_types.
+type NumericTypeColumn string
+
+// Column names of numeric_types.
+const (
+	NumericTypeColumnID                           NumericTypeColumn = "id"
+	NumericTypeColumnSmallintValueNullable        NumericTypeColumn = "smallint_value_nullable"
+	NumericTypeColumnIntegerValueNullable         NumericTypeColumn = "integer_value_nullable"
+	NumericTypeColumnBigintValueNullable          NumericTypeColumn = "bigint_value_nullable"
+	NumericTypeColumnDecimalValueNullable         NumericTypeColumn = "decimal_value_nullable"
+	NumericTypeColumnNumericValueNullable         NumericTypeColumn = "numeric_value_nullable"
+	NumericTypeColumnRealValueNullable            NumericTypeColumn = "real_value_nullable"
+	NumericTypeColumnDoublePrecisionValueNullable NumericTypeColumn = "double_precision_value_nullable"
+	NumericTypeColumnSmallintValue                NumericTypeColumn = "smallint_value"
+	NumericTypeColumnIntegerValue                 NumericTypeColumn = "integer_value"
+	NumericTypeColumnBigintValue                  NumericTypeColumn = "bigint_value"
+	NumericTypeColumnDecimalValue                 NumericTypeColumn = "decimal_value"
+	NumericTypeColumnNumericValue                 NumericTypeColumn = "numeric_value"
+	NumericTypeColumnRealValue                    NumericTypeColumn = "real_value"
+	NumericTypeColumnDoublePrecisionValue         NumericTypeColumn = "double_precision_value"
+	NumericTypeColumnSmallserialValue             NumericTypeColumn = "smallserial_value"
+	NumericTypeColumnSerialValue                  NumericTypeColumn = "serial_value"
+	NumericTypeColumnBigserialValue               NumericTypeColumn = "bigserial_value"
+)
+
+// TableName returns the name of the table of NumericType.
+func (NumericType) TableName() string {
+	return "numeric_types"
+}
+
+// Columns returns the column names of numeric_types in the order of the fields.
+func (NumericType) Columns() []string {
+	return []string{"id", "smallint_value_nullable", "integer_value_nullable", "bigint_value_nullable", "decimal_value_nullable", "numeric_value_nullable", "real_value_nullable", "double_precision_value_nullable", "smallint_value", "integer_value", "bigint_value", "decimal_value", "numeric_value", "real_value", "double_precision_value", "smallserial_value", "serial_value", "bigserial_value"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *NumericType) ScanDest() []any {
+	return []any{&m.ID, &m.SmallintValueNullable, &m.IntegerValueNullable, &m.BigintValueNullable, &m.DecimalValueNullable, &m.NumericValueNullable, &m.RealValueNullable, &m.DoublePrecisionValueNullable, &m.SmallintValue, &m.IntegerValue, &m.BigintValue, &m.DecimalValue, &m.NumericValue, &m.RealValue, &m.DoublePrecisionValue, &m.SmallserialValue, &m.SerialValue, &m.BigserialValue}
+}
+
 // datetime_types
+//
+// primary key: (id)
 type DatetimeType struct {
 	// datetime_types.id
 	ID int
@@ -108,7 +251,7 @@ type DatetimeType struct {
 	TimestamptzValueNullable sql.NullTime
 
 	// datetime_types.interval_value_nullable
-	IntervalValueNullable interface{}
+	IntervalValueNullable sql.NullString
 
 	// datetime_types.date_value
 	DateValue time.Time
@@ -123,10 +266,50 @@ type DatetimeType struct {
 	TimestamptzValue time.Time
 
 	// datetime_types.interval_value
-	IntervalValue interface{}
+	IntervalValue string
+}
+
+// PrimaryKey returns the column names of the primary key of datetime_types.
+func (DatetimeType) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// DatetimeTypeColumn is a column name of datetime_types.
+type DatetimeTypeColumn string
+
+// Column names of datetime_types.
+const (
+	DatetimeTypeColumnID                       DatetimeTypeColumn = "id"
+	DatetimeTypeColumnDateValueNullable        DatetimeTypeColumn = "date_value_nullable"
+	DatetimeTypeColumnTimeValueNullable        DatetimeTypeColumn = "time_value_nullable"
+	DatetimeTypeColumnTimestampValueNullable   DatetimeTypeColumn = "timestamp_value_nullable"
+	DatetimeTypeColumnTimestamptzValueNullable DatetimeTypeColumn = "timestamptz_value_nullable"
+	DatetimeTypeColumnIntervalValueNullable    DatetimeTypeColumn = "interval_value_nullable"
+	DatetimeTypeColumnDateValue                DatetimeTypeColumn = "date_value"
+	DatetimeTypeColumnTimeValue                DatetimeTypeColumn = "time_value"
+	DatetimeTypeColumnTimestampValue           DatetimeTypeColumn = "timestamp_value"
+	DatetimeTypeColumnTimestamptzValue         DatetimeTypeColumn = "timestamptz_value"
+	DatetimeTypeColumnIntervalValue            DatetimeTypeColumn = "interval_value"
+)
+
+// TableName returns the name of the table of DatetimeType.
+func (DatetimeType) TableName() string {
+	return "datetime_types"
+}
+
+// Columns returns the column names of datetime_types in the order of the fields.
+func (DatetimeType) Columns() []string {
+	return []string{"id", "date_value_nullable", "time_value_nullable", "timestamp_value_nullable", "timestamptz_value_nullable", "interval_value_nullable", "date_value", "time_value", "timestamp_value", "timestamptz_value", "interval_value"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *DatetimeType) ScanDest() []any {
+	return []any{&m.ID, &m.DateValueNullable, &m.TimeValueNullable, &m.TimestampValueNullable, &m.TimestamptzValueNullable, &m.IntervalValueNullable, &m.DateValue, &m.TimeValue, &m.TimestampValue, &m.TimestamptzValue, &m.IntervalValue}
 }
 
 // uuid_types
+//
+// primary key: (id)
 type UUIDType struct {
 	// uuid_types.id
 	ID int
@@ -138,7 +321,39 @@ type UUIDType struct {
 	UUIDValue uuid.UUID
 }
 
+// PrimaryKey returns the column names of the primary key of uuid_types.
+func (UUIDType) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// UUIDTypeColumn is a column name of uuid_types.
+type UUIDTypeColumn string
+
+// Column names of uuid_types.
+const (
+	UUIDTypeColumnID                UUIDTypeColumn = "id"
+	UUIDTypeColumnUUIDValueNullable UUIDTypeColumn = "uuid_value_nullable"
+	UUIDTypeColumnUUIDValue         UUIDTypeColumn = "uuid_value"
+)
+
+// TableName returns the name of the table of UUIDType.
+func (UUIDType) TableName() string {
+	return "uuid_types"
+}
+
+// Columns returns the column names of uuid_types in the order of the fields.
+func (UUIDType) Columns() []string {
+	return []string{"id", "uuid_value_nullable", "uuid_value"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *UUIDType) ScanDest() []any {
+	return []any{&m.ID, &m.UUIDValueNullable, &m.UUIDValue}
+}
+
 // money_types
+//
+// primary key: (id)
 type MoneyType struct {
 	// money_types.id
 	ID int
@@ -150,7 +365,39 @@ type MoneyType struct {
 	MoneyValue float64
 }
 
+// PrimaryKey returns the column names of the primary key of money_types.
+func (MoneyType) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// MoneyTypeColumn is a column name of money_types.
+type MoneyTypeColumn string
+
+// Column names of money_types.
+const (
+	MoneyTypeColumnID                 MoneyTypeColumn = "id"
+	MoneyTypeColumnMoneyValueNullable MoneyTypeColumn = "money_value_nullable"
+	MoneyTypeColumnMoneyValue         MoneyTypeColumn = "money_value"
+)
+
+// TableName returns the name of the table of MoneyType.
+func (MoneyType) TableName() string {
+	return "money_types"
+}
+
+// Columns returns the column names of money_types in the order of the fields.
+func (MoneyType) Columns() []string {
+	return []string{"id", "money_value_nullable", "money_value"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *MoneyType) ScanDest() []any {
+	return []any{&m.ID, &m.MoneyValueNullable, &m.MoneyValue}
+}
+
 // boolean_types
+//
+// primary key: (id)
 type BooleanType struct {
 	// boolean_types.id
 	ID int
@@ -161,3 +408,258 @@ type BooleanType struct {
 	// boolean_types.boolean_value
 	BooleanValue bool
 }
+
+// PrimaryKey returns the column names of the primary key of boolean_types.
+func (BooleanType) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// BooleanTypeColumn is a column name of boolean_types.
+type BooleanTypeColumn string
+
+// Column names of boolean_types.
+const (
+	BooleanTypeColumnID                   BooleanTypeColumn = "id"
+	BooleanTypeColumnBooleanValueNullable BooleanTypeColumn = "boolean_value_nullable"
+	BooleanTypeColumnBooleanValue         BooleanTypeColumn = "boolean_value"
+)
+
+// TableName returns the name of the table of BooleanType.
+func (BooleanType) TableName() string {
+	return "boolean_types"
+}
+
+// Columns returns the column names of boolean_types in the order of the fields.
+func (BooleanType) Columns() []string {
+	return []string{"id", "boolean_value_nullable", "boolean_value"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *BooleanType) ScanDest() []any {
+	return []any{&m.ID, &m.BooleanValueNullable, &m.BooleanValue}
+}
+
+// composite_key_types
+//
+// primary key: (tenant_id, code)
+// index: composite_key_types_indexed_value_idx (indexed_value)
+// unique index: composite_key_types_lower_indexed_value_idx (lower(indexed_value))
+// unique index: composite_key_types_unique_value_key (unique_value)
+type CompositeKeyType struct {
+	// composite_key_types.tenant_id
+	TenantID int
+
+	// composite_key_types.code
+	Code string
+
+	// composite_key_types.unique_value
+	UniqueValue string
+
+	// composite_key_types.indexed_value
+	IndexedValue sql.NullString
+}
+
+// PrimaryKey returns the column names of the primary key of composite_key_types.
+func (CompositeKeyType) PrimaryKey() []string {
+	return []string{"tenant_id", "code"}
+}
+
+// CompositeKeyTypeColumn is a column name of composite_key_types.
+type CompositeKeyTypeColumn string
+
+// Column names of composite_key_types.
+const (
+	CompositeKeyTypeColumnTenantID     CompositeKeyTypeColumn = "tenant_id"
+	CompositeKeyTypeColumnCode         CompositeKeyTypeColumn = "code"
+	CompositeKeyTypeColumnUniqueValue  CompositeKeyTypeColumn = "unique_value"
+	CompositeKeyTypeColumnIndexedValue CompositeKeyTypeColumn = "indexed_value"
+)
+
+// TableName returns the name of the table of CompositeKeyType.
+func (CompositeKeyType) TableName() string {
+	return "composite_key_types"
+}
+
+// Columns returns the column names of composite_key_types in the order of the fields.
+func (CompositeKeyType) Columns() []string {
+	return []string{"tenant_id", "code", "unique_value", "indexed_value"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *CompositeKeyType) ScanDest() []any {
+	return []any{&m.TenantID, &m.Code, &m.UniqueValue, &m.IndexedValue}
+}
+
+// enum_types
+//
+// primary key: (id)
+type EnumType struct {
+	// enum_types.id
+	ID int
+
+	// enum_types.mood_value_nullable
+	MoodValueNullable *Mood
+
+	// enum_types.mood_value
+	MoodValue Mood
+}
+
+// PrimaryKey returns the column names of the primary key of enum_types.
+func (EnumType) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// EnumTypeColumn is a column name of enum_types.
+type EnumTypeColumn string
+
+// Column names of enum_types.
+const (
+	EnumTypeColumnID                EnumTypeColumn = "id"
+	EnumTypeColumnMoodValueNullable EnumTypeColumn = "mood_value_nullable"
+	EnumTypeColumnMoodValue         EnumTypeColumn = "mood_value"
+)
+
+// TableName returns the name of the table of EnumType.
+func (EnumType) TableName() string {
+	return "enum_types"
+}
+
+// Columns returns the column names of enum_types in the order of the fields.
+func (EnumType) Columns() []string {
+	return []string{"id", "mood_value_nullable", "mood_value"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *EnumType) ScanDest() []any {
+	return []any{&m.ID, &m.MoodValueNullable, &m.MoodValue}
+}
+
+// array_types
+//
+// primary key: (id)
+type ArrayType struct {
+	// array_types.id
+	ID int
+
+	// array_types.text_array_value_nullable
+	TextArrayValueNullable pq.StringArray
+
+	// array_types.integer_array_value
+	IntegerArrayValue pq.Int32Array
+
+	// array_types.uuid_array_value
+	UUIDArrayValue interface{}
+
+	// array_types.timestamp_array_value
+	TimestampArrayValue interface{}
+
+	// array_types.integer_matrix_value
+	IntegerMatrixValue interface{}
+
+	// array_types.mood_array_value
+	MoodArrayValue interface{}
+}
+
+// PrimaryKey returns the column names of the primary key of array_types.
+func (ArrayType) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// ArrayTypeColumn is a column name of array_types.
+type ArrayTypeColumn string
+
+// Column names of array_types.
+const (
+	ArrayTypeColumnID                     ArrayTypeColumn = "id"
+	ArrayTypeColumnTextArrayValueNullable ArrayTypeColumn = "text_array_value_nullable"
+	ArrayTypeColumnIntegerArrayValue      ArrayTypeColumn = "integer_array_value"
+	ArrayTypeColumnUUIDArrayValue         ArrayTypeColumn = "uuid_array_value"
+	ArrayTypeColumnTimestampArrayValue    ArrayTypeColumn = "timestamp_array_value"
+	ArrayTypeColumnIntegerMatrixValue     ArrayTypeColumn = "integer_matrix_value"
+	ArrayTypeColumnMoodArrayValue         ArrayTypeColumn = "mood_array_value"
+)
+
+// TableName returns the name of the table of ArrayType.
+func (ArrayType) TableName() string {
+	return "array_types"
+}
+
+// Columns returns the column names of array_types in the order of the fields.
+func (ArrayType) Columns() []string {
+	return []string{"id", "text_array_value_nullable", "integer_array_value", "uuid_array_value", "timestamp_array_value", "integer_matrix_value", "mood_array_value"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *ArrayType) ScanDest() []any {
+	return []any{&m.ID, &m.TextArrayValueNullable, &m.IntegerArrayValue, &m.UUIDArrayValue, &m.TimestampArrayValue, &m.IntegerMatrixValue, &m.MoodArrayValue}
+}
+
+// active_authors: authors with a name
+//
+// read-only: view
+type ActiveAuthor struct {
+	// active_authors.id
+	ID int
+
+	// active_authors.name
+	Name string
+}
+
+// ActiveAuthorColumn is a column name of active_authors.
+type ActiveAuthorColumn string
+
+// Column names of active_authors.
+const (
+	ActiveAuthorColumnID   ActiveAuthorColumn = "id"
+	ActiveAuthorColumnName ActiveAuthorColumn = "name"
+)
+
+// TableName returns the name of the table of ActiveAuthor.
+func (ActiveAuthor) TableName() string {
+	return "active_authors"
+}
+
+// Columns returns the column names of active_authors in the order of the fields.
+func (ActiveAuthor) Columns() []string {
+	return []string{"id", "name"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *ActiveAuthor) ScanDest() []any {
+	return []any{&m.ID, &m.Name}
+}
+
+// book_counts
+//
+// read-only: materialized view
+type BookCount struct {
+	// book_counts.author_id
+	AuthorID sql.NullInt32
+
+	// book_counts.book_count
+	BookCount sql.NullInt64
+}
+
+// BookCountColumn is a column name of book_counts.
+type BookCountColumn string
+
+// Column names of book_counts.
+const (
+	BookCountColumnAuthorID  BookCountColumn = "author_id"
+	BookCountColumnBookCount BookCountColumn = "book_count"
+)
+
+// TableName returns the name of the table of BookCount.
+func (BookCount) TableName() string {
+	return "book_counts"
+}
+
+// Columns returns the column names of book_counts in the order of the fields.
+func (BookCount) Columns() []string {
+	return []string{"author_id", "book_count"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *BookCount) ScanDest() []any {
+	return []any{&m.AuthorID, &m.BookCount}
+}
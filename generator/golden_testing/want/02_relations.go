@@ -0,0 +1,249 @@
+package pkgname
+
+import "database/sql"
+
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+// users
+//
+// primary key: (id)
+type User struct {
+	// users.id
+	ID int
+
+	// users.name
+	Name string
+
+	// users.UserProfile: one_to_one users(id) <- user_profiles(user_id)
+	UserProfile *UserProfile
+
+	// users.Posts: one_to_many users(id) <- posts(author_id)
+	Posts []*Post
+
+	// users.SenderMessages: one_to_many users(id) <- messages(sender_id)
+	SenderMessages []*Message
+
+	// users.ReceiverMessages: one_to_many users(id) <- messages(receiver_id)
+	ReceiverMessages []*Message
+}
+
+// PrimaryKey returns the column names of the primary key of users.
+func (User) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// UserColumn is a column name of users.
+type UserColumn string
+
+// Column names of users.
+const (
+	UserColumnID   UserColumn = "id"
+	UserColumnName UserColumn = "name"
+)
+
+// TableName returns the name of the table of User.
+func (User) TableName() string {
+	return "users"
+}
+
+// Columns returns the column names of users in the order of the fields.
+func (User) Columns() []string {
+	return []string{"id", "name"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *User) ScanDest() []any {
+	return []any{&m.ID, &m.Name}
+}
+
+// user_profiles
+//
+// primary key: (user_id)
+type UserProfile struct {
+	// user_profiles.user_id
+	UserID int
+
+	// user_profiles.bio
+	Bio sql.NullString
+
+	// user_profiles.User: one_to_one user_profiles(user_id) -> users(id)
+	User *User
+}
+
+// PrimaryKey returns the column names of the primary key of user_profiles.
+func (UserProfile) PrimaryKey() []string {
+	return []string{"user_id"}
+}
+
+// UserProfileColumn is a column name of user_profiles.
+type UserProfileColumn string
+
+// Column names of user_profiles.
+const (
+	UserProfileColumnUserID UserProfileColumn = "user_id"
+	UserProfileColumnBio    UserProfileColumn = "bio"
+)
+
+// TableName returns the name of the table of UserProfile.
+func (UserProfile) TableName() string {
+	return "user_profiles"
+}
+
+// Columns returns the column names of user_profiles in the order of the fields.
+func (UserProfile) Columns() []string {
+	return []string{"user_id", "bio"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *UserProfile) ScanDest() []any {
+	return []any{&m.UserID, &m.Bio}
+}
+
+// posts
+//
+// primary key: (id)
+type Post struct {
+	// posts.id
+	ID int
+
+	// posts.author_id
+	AuthorID int
+
+	// posts.category_id
+	CategoryID sql.NullInt32
+
+	// posts.Author: many_to_one posts(author_id) -> users(id)
+	Author *User
+
+	// posts.Category: many_to_one posts(category_id) -> categories(id)
+	Category *Category
+}
+
+// PrimaryKey returns the column names of the primary key of posts.
+func (Post) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// PostColumn is a column name of posts.
+type PostColumn string
+
+// Column names of posts.
+const (
+	PostColumnID         PostColumn = "id"
+	PostColumnAuthorID   PostColumn = "author_id"
+	PostColumnCategoryID PostColumn = "category_id"
+)
+
+// TableName returns the name of the table of Post.
+func (Post) TableName() string {
+	return "posts"
+}
+
+// Columns returns the column names of posts in the order of the fields.
+func (Post) Columns() []string {
+	return []string{"id", "author_id", "category_id"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Post) ScanDest() []any {
+	return []any{&m.ID, &m.AuthorID, &m.CategoryID}
+}
+
+// messages
+//
+// primary key: (id)
+type Message struct {
+	// messages.id
+	ID int
+
+	// messages.sender_id
+	SenderID int
+
+	// messages.receiver_id
+	ReceiverID int
+
+	// messages.Sender: many_to_one messages(sender_id) -> users(id)
+	Sender *User
+
+	// messages.Receiver: many_to_one messages(receiver_id) -> users(id)
+	Receiver *User
+}
+
+// PrimaryKey returns the column names of the primary key of messages.
+func (Message) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// MessageColumn is a column name of messages.
+type MessageColumn string
+
+// Column names of messages.
+const (
+	MessageColumnID         MessageColumn = "id"
+	MessageColumnSenderID   MessageColumn = "sender_id"
+	MessageColumnReceiverID MessageColumn = "receiver_id"
+)
+
+// TableName returns the name of the table of Message.
+func (Message) TableName() string {
+	return "messages"
+}
+
+// Columns returns the column names of messages in the order of the fields.
+func (Message) Columns() []string {
+	return []string{"id", "sender_id", "receiver_id"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Message) ScanDest() []any {
+	return []any{&m.ID, &m.SenderID, &m.ReceiverID}
+}
+
+// categories
+//
+// primary key: (id)
+type Category struct {
+	// categories.id
+	ID int
+
+	// categories.parent_id
+	ParentID sql.NullInt32
+
+	// categories.Posts: one_to_many categories(id) <- posts(category_id)
+	Posts []*Post
+
+	// categories.Parent: many_to_one categories(parent_id) -> categories(id)
+	Parent *Category
+
+	// categories.Categories: one_to_many categories(id) <- categories(parent_id)
+	Categories []*Category
+}
+
+// PrimaryKey returns the column names of the primary key of categories.
+func (Category) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// CategoryColumn is a column name of categories.
+type CategoryColumn string
+
+// Column names of categories.
+const (
+	CategoryColumnID       CategoryColumn = "id"
+	CategoryColumnParentID CategoryColumn = "parent_id"
+)
+
+// TableName returns the name of the table of Category.
+func (Category) TableName() string {
+	return "categories"
+}
+
+// Columns returns the column names of categories in the order of the fields.
+func (Category) Columns() []string {
+	return []string{"id", "parent_id"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Category) ScanDest() []any {
+	return []any{&m.ID, &m.ParentID}
+}
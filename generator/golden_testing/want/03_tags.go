@@ -0,0 +1,91 @@
+package pkgname
+
+import "database/sql"
+
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+// users
+//
+// primary key: (id)
+type User struct {
+	// users.id
+	ID int `bun:"id,pk" db:"id" gorm:"column:id;primaryKey" json:"id,omitempty" yaml:"Id"`
+
+	// users.display_name
+	DisplayName sql.NullString `bun:"display_name,nullzero" db:"display_name" gorm:"column:display_name" json:"displayName,omitempty" yaml:"DisplayName"`
+
+	// users.Posts: one_to_many users(id) <- posts(author_id)
+	Posts []*Post `bun:"rel:has-many,join:id=author_id" db:"-" gorm:"foreignKey:AuthorID;references:ID" json:"posts,omitempty" yaml:"Posts"`
+}
+
+// PrimaryKey returns the column names of the primary key of users.
+func (User) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// UserColumn is a column name of users.
+type UserColumn string
+
+// Column names of users.
+const (
+	UserColumnID          UserColumn = "id"
+	UserColumnDisplayName UserColumn = "display_name"
+)
+
+// TableName returns the name of the table of User.
+func (User) TableName() string {
+	return "users"
+}
+
+// Columns returns the column names of users in the order of the fields.
+func (User) Columns() []string {
+	return []string{"id", "display_name"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *User) ScanDest() []any {
+	return []any{&m.ID, &m.DisplayName}
+}
+
+// posts
+//
+// primary key: (id)
+type Post struct {
+	// posts.id
+	ID int `bun:"id,pk" db:"id" gorm:"column:id;primaryKey" json:"id,omitempty" yaml:"Id"`
+
+	// posts.author_id
+	AuthorID int `bun:"author_id" db:"author_id" gorm:"column:author_id" json:"authorId,omitempty" yaml:"AuthorId"`
+
+	// posts.Author: many_to_one posts(author_id) -> users(id)
+	Author *User `bun:"rel:belongs-to,join:author_id=id" db:"-" gorm:"foreignKey:AuthorID;references:ID" json:"author,omitempty" yaml:"Author"`
+}
+
+// PrimaryKey returns the column names of the primary key of posts.
+func (Post) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// PostColumn is a column name of posts.
+type PostColumn string
+
+// Column names of posts.
+const (
+	PostColumnID       PostColumn = "id"
+	PostColumnAuthorID PostColumn = "author_id"
+)
+
+// TableName returns the name of the table of Post.
+func (Post) TableName() string {
+	return "posts"
+}
+
+// Columns returns the column names of posts in the order of the fields.
+func (Post) Columns() []string {
+	return []string{"id", "author_id"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Post) ScanDest() []any {
+	return []any{&m.ID, &m.AuthorID}
+}
@@ -0,0 +1,33 @@
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+package pkgname
+
+import (
+	"database/sql"
+	uuid "github.com/google/uuid"
+	null "github.com/guregu/null"
+	pq "github.com/lib/pq"
+)
+
+type UserStatus string
+
+// User is a row of users.
+type User struct {
+	ID          uuid.UUID      `db:"id"`
+	DisplayName sql.NullString `db:"display_name"`
+	Status      UserStatus     `db:"status"`
+	Tags        pq.StringArray `db:"tags"`
+	DeletedAt   null.Time      `db:"deleted_at"`
+}
+
+// usersTable is the name of users.
+const usersTable = "users"
+
+// UserProfile is a row of user_profiles.
+type UserProfile struct {
+	UserID uuid.UUID      `db:"user_id"`
+	Bio    sql.NullString `db:"bio"`
+}
+
+// userProfilesTable is the name of user_profiles.
+const userProfilesTable = "user_profiles"
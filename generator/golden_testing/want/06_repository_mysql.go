@@ -0,0 +1,354 @@
+package pkgname
+
+import (
+	"context"
+	"database/sql"
+	uuid "github.com/google/uuid"
+	"strings"
+)
+
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+// DBTX is the interface satisfied by *sql.DB, *sql.Tx and *sql.Conn.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// users
+//
+// primary key: (id)
+type User struct {
+	// users.id
+	ID uuid.UUID
+
+	// users.name
+	Name string
+
+	// users.email
+	Email sql.NullString
+}
+
+// PrimaryKey returns the column names of the primary key of users.
+func (User) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// UserColumn is a column name of users.
+type UserColumn string
+
+// Column names of users.
+const (
+	UserColumnID    UserColumn = "id"
+	UserColumnName  UserColumn = "name"
+	UserColumnEmail UserColumn = "email"
+)
+
+// TableName returns the name of the table of User.
+func (User) TableName() string {
+	return "users"
+}
+
+// Columns returns the column names of users in the order of the fields.
+func (User) Columns() []string {
+	return []string{"id", "name", "email"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *User) ScanDest() []any {
+	return []any{&m.ID, &m.Name, &m.Email}
+}
+
+// UserRepository provides CRUD operations of users.
+type UserRepository struct {
+	db DBTX
+}
+
+// NewUserRepository returns a repository of users. db is either *sql.DB, *sql.Tx or *sql.Conn.
+func NewUserRepository(db DBTX) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+// Insert inserts the row into users.
+func (r *UserRepository) Insert(ctx context.Context, m *User) error {
+	_, err := r.db.ExecContext(ctx, "INSERT INTO `users` (`id`, `name`, `email`) VALUES (?, ?, ?)", m.ID, m.Name, m.Email)
+
+	return err
+}
+
+// InsertBatch inserts the rows into users, 21845 rows per statement to stay within the 65535 bind parameters.
+// Call it in a transaction to insert all or none of the rows when more rows are given.
+func (r *UserRepository) InsertBatch(ctx context.Context, ms []*User) error {
+	for len(ms) > 0 {
+		batch := ms
+		if len(batch) > 21845 {
+			batch = batch[:21845]
+		}
+		ms = ms[len(batch):]
+
+		var b strings.Builder
+		b.WriteString("INSERT INTO `users` (`id`, `name`, `email`) VALUES ")
+		args := make([]any, 0, len(batch)*3)
+		for i, m := range batch {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString("(?, ?, ?)")
+			args = append(args, m.ID, m.Name, m.Email)
+		}
+
+		if _, err := r.db.ExecContext(ctx, b.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByPK returns the row of users with the primary key. sql.ErrNoRows is returned when it does not exist.
+func (r *UserRepository) FindByPK(ctx context.Context, id uuid.UUID) (*User, error) {
+	var m User
+	if err := r.db.QueryRowContext(ctx, "SELECT `id`, `name`, `email` FROM `users` WHERE `id` = ?", id).Scan(&m.ID, &m.Name, &m.Email); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Update updates the columns of the row of users except for the primary key.
+func (r *UserRepository) Update(ctx context.Context, m *User) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE `users` SET `name` = ?, `email` = ? WHERE `id` = ?", m.Name, m.Email, m.ID)
+
+	return err
+}
+
+// Delete deletes the row of users with the primary key.
+func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM `users` WHERE `id` = ?", id)
+
+	return err
+}
+
+// Upsert inserts the row into users, or updates the row with the same primary key.
+func (r *UserRepository) Upsert(ctx context.Context, m *User) error {
+	_, err := r.db.ExecContext(ctx, "INSERT INTO `users` (`id`, `name`, `email`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `email` = VALUES(`email`)", m.ID, m.Name, m.Email)
+
+	return err
+}
+
+// user_roles
+//
+// primary key: (type, user_id)
+type UserRole struct {
+	// user_roles.user_id
+	UserID uuid.UUID
+
+	// user_roles.type
+	Type string
+}
+
+// PrimaryKey returns the column names of the primary key of user_roles.
+func (UserRole) PrimaryKey() []string {
+	return []string{"type", "user_id"}
+}
+
+// UserRoleColumn is a column name of user_roles.
+type UserRoleColumn string
+
+// Column names of user_roles.
+const (
+	UserRoleColumnUserID UserRoleColumn = "user_id"
+	UserRoleColumnType   UserRoleColumn = "type"
+)
+
+// TableName returns the name of the table of UserRole.
+func (UserRole) TableName() string {
+	return "user_roles"
+}
+
+// Columns returns the column names of user_roles in the order of the fields.
+func (UserRole) Columns() []string {
+	return []string{"user_id", "type"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *UserRole) ScanDest() []any {
+	return []any{&m.UserID, &m.Type}
+}
+
+// UserRoleRepository provides CRUD operations of user_roles.
+type UserRoleRepository struct {
+	db DBTX
+}
+
+// NewUserRoleRepository returns a repository of user_roles. db is either *sql.DB, *sql.Tx or *sql.Conn.
+func NewUserRoleRepository(db DBTX) *UserRoleRepository {
+	return &UserRoleRepository{db: db}
+}
+
+// Insert inserts the row into user_roles.
+func (r *UserRoleRepository) Insert(ctx context.Context, m *UserRole) error {
+	_, err := r.db.ExecContext(ctx, "INSERT INTO `user_roles` (`user_id`, `type`) VALUES (?, ?)", m.UserID, m.Type)
+
+	return err
+}
+
+// InsertBatch inserts the rows into user_roles, 32767 rows per statement to stay within the 65535 bind parameters.
+// Call it in a transaction to insert all or none of the rows when more rows are given.
+func (r *UserRoleRepository) InsertBatch(ctx context.Context, ms []*UserRole) error {
+	for len(ms) > 0 {
+		batch := ms
+		if len(batch) > 32767 {
+			batch = batch[:32767]
+		}
+		ms = ms[len(batch):]
+
+		var b strings.Builder
+		b.WriteString("INSERT INTO `user_roles` (`user_id`, `type`) VALUES ")
+		args := make([]any, 0, len(batch)*2)
+		for i, m := range batch {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString("(?, ?)")
+			args = append(args, m.UserID, m.Type)
+		}
+
+		if _, err := r.db.ExecContext(ctx, b.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByPK returns the row of user_roles with the primary key. sql.ErrNoRows is returned when it does not exist.
+func (r *UserRoleRepository) FindByPK(ctx context.Context, type_ string, userID uuid.UUID) (*UserRole, error) {
+	var m UserRole
+	if err := r.db.QueryRowContext(ctx, "SELECT `user_id`, `type` FROM `user_roles` WHERE `type` = ? AND `user_id` = ?", type_, userID).Scan(&m.UserID, &m.Type); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Delete deletes the row of user_roles with the primary key.
+func (r *UserRoleRepository) Delete(ctx context.Context, type_ string, userID uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM `user_roles` WHERE `type` = ? AND `user_id` = ?", type_, userID)
+
+	return err
+}
+
+// Upsert inserts the row into user_roles, or updates the row with the same primary key.
+func (r *UserRoleRepository) Upsert(ctx context.Context, m *UserRole) error {
+	_, err := r.db.ExecContext(ctx, "INSERT INTO `user_roles` (`user_id`, `type`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `type` = `type`", m.UserID, m.Type)
+
+	return err
+}
+
+// audit_logs
+type AuditLog struct {
+	// audit_logs.message
+	Message string
+}
+
+// AuditLogColumn is a column name of audit_logs.
+type AuditLogColumn string
+
+// Column names of audit_logs.
+const (
+	AuditLogColumnMessage AuditLogColumn = "message"
+)
+
+// TableName returns the name of the table of AuditLog.
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// Columns returns the column names of audit_logs in the order of the fields.
+func (AuditLog) Columns() []string {
+	return []string{"message"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *AuditLog) ScanDest() []any {
+	return []any{&m.Message}
+}
+
+// AuditLogRepository provides CRUD operations of audit_logs.
+type AuditLogRepository struct {
+	db DBTX
+}
+
+// NewAuditLogRepository returns a repository of audit_logs. db is either *sql.DB, *sql.Tx or *sql.Conn.
+func NewAuditLogRepository(db DBTX) *AuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
+
+// Insert inserts the row into audit_logs.
+func (r *AuditLogRepository) Insert(ctx context.Context, m *AuditLog) error {
+	_, err := r.db.ExecContext(ctx, "INSERT INTO `audit_logs` (`message`) VALUES (?)", m.Message)
+
+	return err
+}
+
+// InsertBatch inserts the rows into audit_logs, 65535 rows per statement to stay within the 65535 bind parameters.
+// Call it in a transaction to insert all or none of the rows when more rows are given.
+func (r *AuditLogRepository) InsertBatch(ctx context.Context, ms []*AuditLog) error {
+	for len(ms) > 0 {
+		batch := ms
+		if len(batch) > 65535 {
+			batch = batch[:65535]
+		}
+		ms = ms[len(batch):]
+
+		var b strings.Builder
+		b.WriteString("INSERT INTO `audit_logs` (`message`) VALUES ")
+		args := make([]any, 0, len(batch))
+		for i, m := range batch {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString("(?)")
+			args = append(args, m.Message)
+		}
+
+		if _, err := r.db.ExecContext(ctx, b.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// active_users
+//
+// read-only: view
+type ActiveUser struct {
+	// active_users.id
+	ID uuid.UUID
+}
+
+// ActiveUserColumn is a column name of active_users.
+type ActiveUserColumn string
+
+// Column names of active_users.
+const (
+	ActiveUserColumnID ActiveUserColumn = "id"
+)
+
+// TableName returns the name of the table of ActiveUser.
+func (ActiveUser) TableName() string {
+	return "active_users"
+}
+
+// Columns returns the column names of active_users in the order of the fields.
+func (ActiveUser) Columns() []string {
+	return []string{"id"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *ActiveUser) ScanDest() []any {
+	return []any{&m.ID}
+}
@@ -0,0 +1,371 @@
+package pkgname
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	uuid "github.com/google/uuid"
+	null "github.com/guregu/null"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+// status
+type Status string
+
+const (
+	StatusActive Status = "active"
+)
+
+// Values returns all values of Status in the order of the definition.
+func (Status) Values() []Status {
+	return []Status{StatusActive}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Status) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of Status.
+func (e Status) IsValid() bool {
+	switch e {
+	case StatusActive:
+		return true
+	}
+	return false
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *Status) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		*e = Status(v)
+	case []byte:
+		*e = Status(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Status", src)
+	}
+
+	if !e.IsValid() {
+		return fmt.Errorf("invalid Status: %q", *e)
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (e Status) Value() (driver.Value, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid Status: %q", e)
+	}
+
+	return string(e), nil
+}
+
+// sql_items
+type SQLItem struct {
+	// sql_items.id
+	ID int
+
+	// sql_items.name
+	Name sql.NullString
+
+	// sql_items.age
+	Age sql.Null[int]
+
+	// sql_items.score
+	Score sql.NullInt64
+
+	// sql_items.born_on
+	BornOn sql.NullTime
+
+	// sql_items.deleted_at
+	DeletedAt null.Time
+
+	// sql_items.token
+	Token sql.Null[uuid.UUID]
+
+	// sql_items.avatar
+	Avatar []byte
+
+	// sql_items.status
+	Status sql.Null[Status]
+}
+
+// SQLItemColumn is a column name of sql_items.
+type SQLItemColumn string
+
+// Column names of sql_items.
+const (
+	SQLItemColumnID        SQLItemColumn = "id"
+	SQLItemColumnName      SQLItemColumn = "name"
+	SQLItemColumnAge       SQLItemColumn = "age"
+	SQLItemColumnScore     SQLItemColumn = "score"
+	SQLItemColumnBornOn    SQLItemColumn = "born_on"
+	SQLItemColumnDeletedAt SQLItemColumn = "deleted_at"
+	SQLItemColumnToken     SQLItemColumn = "token"
+	SQLItemColumnAvatar    SQLItemColumn = "avatar"
+	SQLItemColumnStatus    SQLItemColumn = "status"
+)
+
+// TableName returns the name of the table of SQLItem.
+func (SQLItem) TableName() string {
+	return "sql_items"
+}
+
+// Columns returns the column names of sql_items in the order of the fields.
+func (SQLItem) Columns() []string {
+	return []string{"id", "name", "age", "score", "born_on", "deleted_at", "token", "avatar", "status"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *SQLItem) ScanDest() []any {
+	return []any{&m.ID, &m.Name, &m.Age, &m.Score, &m.BornOn, &m.DeletedAt, &m.Token, &m.Avatar, &m.Status}
+}
+
+// pointer_items
+type PointerItem struct {
+	// pointer_items.id
+	ID int
+
+	// pointer_items.name
+	Name *string
+
+	// pointer_items.age
+	Age *int
+
+	// pointer_items.score
+	Score *int64
+
+	// pointer_items.born_on
+	BornOn *time.Time
+
+	// pointer_items.deleted_at
+	DeletedAt null.Time
+
+	// pointer_items.token
+	Token *uuid.UUID
+
+	// pointer_items.avatar
+	Avatar []byte
+
+	// pointer_items.status
+	Status *Status
+}
+
+// PointerItemColumn is a column name of pointer_items.
+type PointerItemColumn string
+
+// Column names of pointer_items.
+const (
+	PointerItemColumnID        PointerItemColumn = "id"
+	PointerItemColumnName      PointerItemColumn = "name"
+	PointerItemColumnAge       PointerItemColumn = "age"
+	PointerItemColumnScore     PointerItemColumn = "score"
+	PointerItemColumnBornOn    PointerItemColumn = "born_on"
+	PointerItemColumnDeletedAt PointerItemColumn = "deleted_at"
+	PointerItemColumnToken     PointerItemColumn = "token"
+	PointerItemColumnAvatar    PointerItemColumn = "avatar"
+	PointerItemColumnStatus    PointerItemColumn = "status"
+)
+
+// TableName returns the name of the table of PointerItem.
+func (PointerItem) TableName() string {
+	return "pointer_items"
+}
+
+// Columns returns the column names of pointer_items in the order of the fields.
+func (PointerItem) Columns() []string {
+	return []string{"id", "name", "age", "score", "born_on", "deleted_at", "token", "avatar", "status"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *PointerItem) ScanDest() []any {
+	return []any{&m.ID, &m.Name, &m.Age, &m.Score, &m.BornOn, &m.DeletedAt, &m.Token, &m.Avatar, &m.Status}
+}
+
+// generic_items
+type GenericItem struct {
+	// generic_items.id
+	ID int
+
+	// generic_items.name
+	Name sql.Null[string]
+
+	// generic_items.age
+	Age sql.Null[int]
+
+	// generic_items.score
+	Score sql.Null[int64]
+
+	// generic_items.born_on
+	BornOn sql.Null[time.Time]
+
+	// generic_items.deleted_at
+	DeletedAt null.Time
+
+	// generic_items.token
+	Token sql.Null[uuid.UUID]
+
+	// generic_items.avatar
+	Avatar []byte
+
+	// generic_items.status
+	Status sql.Null[Status]
+}
+
+// GenericItemColumn is a column name of generic_items.
+type GenericItemColumn string
+
+// Column names of generic_items.
+const (
+	GenericItemColumnID        GenericItemColumn = "id"
+	GenericItemColumnName      GenericItemColumn = "name"
+	GenericItemColumnAge       GenericItemColumn = "age"
+	GenericItemColumnScore     GenericItemColumn = "score"
+	GenericItemColumnBornOn    GenericItemColumn = "born_on"
+	GenericItemColumnDeletedAt GenericItemColumn = "deleted_at"
+	GenericItemColumnToken     GenericItemColumn = "token"
+	GenericItemColumnAvatar    GenericItemColumn = "avatar"
+	GenericItemColumnStatus    GenericItemColumn = "status"
+)
+
+// TableName returns the name of the table of GenericItem.
+func (GenericItem) TableName() string {
+	return "generic_items"
+}
+
+// Columns returns the column names of generic_items in the order of the fields.
+func (GenericItem) Columns() []string {
+	return []string{"id", "name", "age", "score", "born_on", "deleted_at", "token", "avatar", "status"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *GenericItem) ScanDest() []any {
+	return []any{&m.ID, &m.Name, &m.Age, &m.Score, &m.BornOn, &m.DeletedAt, &m.Token, &m.Avatar, &m.Status}
+}
+
+// guregu_items
+type GureguItem struct {
+	// guregu_items.id
+	ID int
+
+	// guregu_items.name
+	Name null.String
+
+	// guregu_items.age
+	Age null.Int
+
+	// guregu_items.score
+	Score null.Int
+
+	// guregu_items.born_on
+	BornOn null.Time
+
+	// guregu_items.deleted_at
+	DeletedAt null.Time
+
+	// guregu_items.token
+	Token *uuid.UUID
+
+	// guregu_items.avatar
+	Avatar []byte
+
+	// guregu_items.status
+	Status *Status
+}
+
+// GureguItemColumn is a column name of guregu_items.
+type GureguItemColumn string
+
+// Column names of guregu_items.
+const (
+	GureguItemColumnID        GureguItemColumn = "id"
+	GureguItemColumnName      GureguItemColumn = "name"
+	GureguItemColumnAge       GureguItemColumn = "age"
+	GureguItemColumnScore     GureguItemColumn = "score"
+	GureguItemColumnBornOn    GureguItemColumn = "born_on"
+	GureguItemColumnDeletedAt GureguItemColumn = "deleted_at"
+	GureguItemColumnToken     GureguItemColumn = "token"
+	GureguItemColumnAvatar    GureguItemColumn = "avatar"
+	GureguItemColumnStatus    GureguItemColumn = "status"
+)
+
+// TableName returns the name of the table of GureguItem.
+func (GureguItem) TableName() string {
+	return "guregu_items"
+}
+
+// Columns returns the column names of guregu_items in the order of the fields.
+func (GureguItem) Columns() []string {
+	return []string{"id", "name", "age", "score", "born_on", "deleted_at", "token", "avatar", "status"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *GureguItem) ScanDest() []any {
+	return []any{&m.ID, &m.Name, &m.Age, &m.Score, &m.BornOn, &m.DeletedAt, &m.Token, &m.Avatar, &m.Status}
+}
+
+// pgtype_items
+type PgtypeItem struct {
+	// pgtype_items.id
+	ID int
+
+	// pgtype_items.name
+	Name pgtype.Text
+
+	// pgtype_items.age
+	Age pgtype.Int4
+
+	// pgtype_items.score
+	Score pgtype.Int8
+
+	// pgtype_items.born_on
+	BornOn pgtype.Date
+
+	// pgtype_items.deleted_at
+	DeletedAt null.Time
+
+	// pgtype_items.token
+	Token pgtype.UUID
+
+	// pgtype_items.avatar
+	Avatar []byte
+
+	// pgtype_items.status
+	Status *Status
+}
+
+// PgtypeItemColumn is a column name of pgtype_items.
+type PgtypeItemColumn string
+
+// Column names of pgtype_items.
+const (
+	PgtypeItemColumnID        PgtypeItemColumn = "id"
+	PgtypeItemColumnName      PgtypeItemColumn = "name"
+	PgtypeItemColumnAge       PgtypeItemColumn = "age"
+	PgtypeItemColumnScore     PgtypeItemColumn = "score"
+	PgtypeItemColumnBornOn    PgtypeItemColumn = "born_on"
+	PgtypeItemColumnDeletedAt PgtypeItemColumn = "deleted_at"
+	PgtypeItemColumnToken     PgtypeItemColumn = "token"
+	PgtypeItemColumnAvatar    PgtypeItemColumn = "avatar"
+	PgtypeItemColumnStatus    PgtypeItemColumn = "status"
+)
+
+// TableName returns the name of the table of PgtypeItem.
+func (PgtypeItem) TableName() string {
+	return "pgtype_items"
+}
+
+// Columns returns the column names of pgtype_items in the order of the fields.
+func (PgtypeItem) Columns() []string {
+	return []string{"id", "name", "age", "score", "born_on", "deleted_at", "token", "avatar", "status"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *PgtypeItem) ScanDest() []any {
+	return []any{&m.ID, &m.Name, &m.Age, &m.Score, &m.BornOn, &m.DeletedAt, &m.Token, &m.Avatar, &m.Status}
+}
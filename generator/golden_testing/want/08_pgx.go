@@ -0,0 +1,88 @@
+package pkgname
+
+import (
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	"net/netip"
+)
+
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+// payments
+//
+// primary key: (id)
+type Payment struct {
+	// payments.id
+	ID pgtype.UUID
+
+	// payments.seq
+	Seq int64
+
+	// payments.amount
+	Amount pgtype.Numeric
+
+	// payments.fee
+	Fee pgtype.Text
+
+	// payments.retry_after
+	RetryAfter pgtype.Interval
+
+	// payments.client_ip
+	ClientIP netip.Prefix
+
+	// payments.attempts
+	Attempts pgtype.Int4
+
+	// payments.memo
+	Memo pgtype.Text
+
+	// payments.payload
+	Payload []byte
+
+	// payments.paid_at
+	PaidAt pgtype.Timestamptz
+
+	// payments.valid_during
+	ValidDuring pgtype.Range[pgtype.Timestamptz]
+
+	// payments.tags
+	Tags []string
+}
+
+// PrimaryKey returns the column names of the primary key of payments.
+func (Payment) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// PaymentColumn is a column name of payments.
+type PaymentColumn string
+
+// Column names of payments.
+const (
+	PaymentColumnID          PaymentColumn = "id"
+	PaymentColumnSeq         PaymentColumn = "seq"
+	PaymentColumnAmount      PaymentColumn = "amount"
+	PaymentColumnFee         PaymentColumn = "fee"
+	PaymentColumnRetryAfter  PaymentColumn = "retry_after"
+	PaymentColumnClientIP    PaymentColumn = "client_ip"
+	PaymentColumnAttempts    PaymentColumn = "attempts"
+	PaymentColumnMemo        PaymentColumn = "memo"
+	PaymentColumnPayload     PaymentColumn = "payload"
+	PaymentColumnPaidAt      PaymentColumn = "paid_at"
+	PaymentColumnValidDuring PaymentColumn = "valid_during"
+	PaymentColumnTags        PaymentColumn = "tags"
+)
+
+// TableName returns the name of the table of Payment.
+func (Payment) TableName() string {
+	return "payments"
+}
+
+// Columns returns the column names of payments in the order of the fields.
+func (Payment) Columns() []string {
+	return []string{"id", "seq", "amount", "fee", "retry_after", "client_ip", "attempts", "memo", "payload", "paid_at", "valid_during", "tags"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Payment) ScanDest() []any {
+	return []any{&m.ID, &m.Seq, &m.Amount, &m.Fee, &m.RetryAfter, &m.ClientIP, &m.Attempts, &m.Memo, &m.Payload, &m.PaidAt, &m.ValidDuring, &m.Tags}
+}
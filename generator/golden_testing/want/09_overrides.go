@@ -0,0 +1,93 @@
+package pkgname
+
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+// users: accounts of the service
+//
+// primary key: (id)
+type Account struct {
+	// users.id
+	ID int `json:"id"`
+
+	// users.metadata: typed jsonb
+	Metadata UserMetadata `json:"metadata"`
+
+	// users.Orders: one_to_many users(id) <- orders(user_id)
+	Orders []*Order `json:"orders"`
+}
+
+// PrimaryKey returns the column names of the primary key of users.
+func (Account) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// AccountColumn is a column name of users.
+type AccountColumn string
+
+// Column names of users.
+const (
+	AccountColumnID       AccountColumn = "id"
+	AccountColumnMetadata AccountColumn = "metadata"
+)
+
+// TableName returns the name of the table of Account.
+func (Account) TableName() string {
+	return "users"
+}
+
+// Columns returns the column names of users in the order of the fields.
+func (Account) Columns() []string {
+	return []string{"id", "metadata"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Account) ScanDest() []any {
+	return []any{&m.ID, &m.Metadata}
+}
+
+// orders
+//
+// primary key: (id)
+type Order struct {
+	// orders.id
+	ID int `json:"id"`
+
+	// orders.user_id
+	AccountID int `json:"user_id"`
+
+	// orders.qty
+	Quantity int `json:"quantity" validate:"min=1"`
+
+	// orders.User: many_to_one orders(user_id) -> users(id)
+	User *Account `json:"user"`
+}
+
+// PrimaryKey returns the column names of the primary key of orders.
+func (Order) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// OrderColumn is a column name of orders.
+type OrderColumn string
+
+// Column names of orders.
+const (
+	OrderColumnID        OrderColumn = "id"
+	OrderColumnAccountID OrderColumn = "user_id"
+	OrderColumnQuantity  OrderColumn = "qty"
+)
+
+// TableName returns the name of the table of Order.
+func (Order) TableName() string {
+	return "orders"
+}
+
+// Columns returns the column names of orders in the order of the fields.
+func (Order) Columns() []string {
+	return []string{"id", "user_id", "qty"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Order) ScanDest() []any {
+	return []any{&m.ID, &m.AccountID, &m.Quantity}
+}
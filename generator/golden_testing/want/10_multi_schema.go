@@ -0,0 +1,468 @@
+package pkgname
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+// status
+type AuthStatus string
+
+const (
+	AuthStatusActive AuthStatus = "active"
+	AuthStatusLocked AuthStatus = "locked"
+)
+
+// Values returns all values of AuthStatus in the order of the definition.
+func (AuthStatus) Values() []AuthStatus {
+	return []AuthStatus{AuthStatusActive, AuthStatusLocked}
+}
+
+// String implements the fmt.Stringer interface.
+func (e AuthStatus) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of AuthStatus.
+func (e AuthStatus) IsValid() bool {
+	switch e {
+	case AuthStatusActive, AuthStatusLocked:
+		return true
+	}
+	return false
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *AuthStatus) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		*e = AuthStatus(v)
+	case []byte:
+		*e = AuthStatus(v)
+	default:
+		return fmt.Errorf("cannot scan %T into AuthStatus", src)
+	}
+
+	if !e.IsValid() {
+		return fmt.Errorf("invalid AuthStatus: %q", *e)
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (e AuthStatus) Value() (driver.Value, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid AuthStatus: %q", e)
+	}
+
+	return string(e), nil
+}
+
+// status
+type BillingStatus string
+
+const (
+	BillingStatusDraft BillingStatus = "draft"
+	BillingStatusPaid  BillingStatus = "paid"
+)
+
+// Values returns all values of BillingStatus in the order of the definition.
+func (BillingStatus) Values() []BillingStatus {
+	return []BillingStatus{BillingStatusDraft, BillingStatusPaid}
+}
+
+// String implements the fmt.Stringer interface.
+func (e BillingStatus) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of BillingStatus.
+func (e BillingStatus) IsValid() bool {
+	switch e {
+	case BillingStatusDraft, BillingStatusPaid:
+		return true
+	}
+	return false
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *BillingStatus) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		*e = BillingStatus(v)
+	case []byte:
+		*e = BillingStatus(v)
+	default:
+		return fmt.Errorf("cannot scan %T into BillingStatus", src)
+	}
+
+	if !e.IsValid() {
+		return fmt.Errorf("invalid BillingStatus: %q", *e)
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (e BillingStatus) Value() (driver.Value, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid BillingStatus: %q", e)
+	}
+
+	return string(e), nil
+}
+
+// DBTX is the interface satisfied by *sql.DB, *sql.Tx and *sql.Conn.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// users
+//
+// primary key: (id)
+type AuthUser struct {
+	// users.id
+	ID int
+
+	// users.status
+	Status AuthStatus
+}
+
+// PrimaryKey returns the column names of the primary key of users.
+func (AuthUser) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// AuthUserColumn is a column name of users.
+type AuthUserColumn string
+
+// Column names of users.
+const (
+	AuthUserColumnID     AuthUserColumn = "id"
+	AuthUserColumnStatus AuthUserColumn = "status"
+)
+
+// TableName returns the name of the table of AuthUser.
+func (AuthUser) TableName() string {
+	return "auth.users"
+}
+
+// Columns returns the column names of users in the order of the fields.
+func (AuthUser) Columns() []string {
+	return []string{"id", "status"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *AuthUser) ScanDest() []any {
+	return []any{&m.ID, &m.Status}
+}
+
+// AuthUserRepository provides CRUD operations of users.
+type AuthUserRepository struct {
+	db DBTX
+}
+
+// NewAuthUserRepository returns a repository of users. db is either *sql.DB, *sql.Tx or *sql.Conn.
+func NewAuthUserRepository(db DBTX) *AuthUserRepository {
+	return &AuthUserRepository{db: db}
+}
+
+// Insert inserts the row into users.
+func (r *AuthUserRepository) Insert(ctx context.Context, m *AuthUser) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO "auth"."users" ("id", "status") VALUES ($1, $2)`, m.ID, m.Status)
+
+	return err
+}
+
+// InsertBatch inserts the rows into users, 32767 rows per statement to stay within the 65535 bind parameters.
+// Call it in a transaction to insert all or none of the rows when more rows are given.
+func (r *AuthUserRepository) InsertBatch(ctx context.Context, ms []*AuthUser) error {
+	for len(ms) > 0 {
+		batch := ms
+		if len(batch) > 32767 {
+			batch = batch[:32767]
+		}
+		ms = ms[len(batch):]
+
+		var b strings.Builder
+		b.WriteString(`INSERT INTO "auth"."users" ("id", "status") VALUES `)
+		args := make([]any, 0, len(batch)*2)
+		for i, m := range batch {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			n := i * 2
+			fmt.Fprintf(&b, "($%d, $%d)", n+1, n+2)
+			args = append(args, m.ID, m.Status)
+		}
+
+		if _, err := r.db.ExecContext(ctx, b.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByPK returns the row of users with the primary key. sql.ErrNoRows is returned when it does not exist.
+func (r *AuthUserRepository) FindByPK(ctx context.Context, id int) (*AuthUser, error) {
+	var m AuthUser
+	if err := r.db.QueryRowContext(ctx, `SELECT "id", "status" FROM "auth"."users" WHERE "id" = $1`, id).Scan(&m.ID, &m.Status); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Update updates the columns of the row of users except for the primary key.
+func (r *AuthUserRepository) Update(ctx context.Context, m *AuthUser) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE "auth"."users" SET "status" = $1 WHERE "id" = $2`, m.Status, m.ID)
+
+	return err
+}
+
+// Delete deletes the row of users with the primary key.
+func (r *AuthUserRepository) Delete(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM "auth"."users" WHERE "id" = $1`, id)
+
+	return err
+}
+
+// Upsert inserts the row into users, or updates the row with the same primary key.
+func (r *AuthUserRepository) Upsert(ctx context.Context, m *AuthUser) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO "auth"."users" ("id", "status") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "status" = EXCLUDED."status"`, m.ID, m.Status)
+
+	return err
+}
+
+// invoices
+//
+// primary key: (id)
+type BillingInvoice struct {
+	// invoices.id
+	ID int
+
+	// invoices.status
+	Status BillingStatus
+}
+
+// PrimaryKey returns the column names of the primary key of invoices.
+func (BillingInvoice) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// BillingInvoiceColumn is a column name of invoices.
+type BillingInvoiceColumn string
+
+// Column names of invoices.
+const (
+	BillingInvoiceColumnID     BillingInvoiceColumn = "id"
+	BillingInvoiceColumnStatus BillingInvoiceColumn = "status"
+)
+
+// TableName returns the name of the table of BillingInvoice.
+func (BillingInvoice) TableName() string {
+	return "billing.invoices"
+}
+
+// Columns returns the column names of invoices in the order of the fields.
+func (BillingInvoice) Columns() []string {
+	return []string{"id", "status"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *BillingInvoice) ScanDest() []any {
+	return []any{&m.ID, &m.Status}
+}
+
+// BillingInvoiceRepository provides CRUD operations of invoices.
+type BillingInvoiceRepository struct {
+	db DBTX
+}
+
+// NewBillingInvoiceRepository returns a repository of invoices. db is either *sql.DB, *sql.Tx or *sql.Conn.
+func NewBillingInvoiceRepository(db DBTX) *BillingInvoiceRepository {
+	return &BillingInvoiceRepository{db: db}
+}
+
+// Insert inserts the row into invoices.
+func (r *BillingInvoiceRepository) Insert(ctx context.Context, m *BillingInvoice) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO "billing"."invoices" ("id", "status") VALUES ($1, $2)`, m.ID, m.Status)
+
+	return err
+}
+
+// InsertBatch inserts the rows into invoices, 32767 rows per statement to stay within the 65535 bind parameters.
+// Call it in a transaction to insert all or none of the rows when more rows are given.
+func (r *BillingInvoiceRepository) InsertBatch(ctx context.Context, ms []*BillingInvoice) error {
+	for len(ms) > 0 {
+		batch := ms
+		if len(batch) > 32767 {
+			batch = batch[:32767]
+		}
+		ms = ms[len(batch):]
+
+		var b strings.Builder
+		b.WriteString(`INSERT INTO "billing"."invoices" ("id", "status") VALUES `)
+		args := make([]any, 0, len(batch)*2)
+		for i, m := range batch {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			n := i * 2
+			fmt.Fprintf(&b, "($%d, $%d)", n+1, n+2)
+			args = append(args, m.ID, m.Status)
+		}
+
+		if _, err := r.db.ExecContext(ctx, b.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByPK returns the row of invoices with the primary key. sql.ErrNoRows is returned when it does not exist.
+func (r *BillingInvoiceRepository) FindByPK(ctx context.Context, id int) (*BillingInvoice, error) {
+	var m BillingInvoice
+	if err := r.db.QueryRowContext(ctx, `SELECT "id", "status" FROM "billing"."invoices" WHERE "id" = $1`, id).Scan(&m.ID, &m.Status); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Update updates the columns of the row of invoices except for the primary key.
+func (r *BillingInvoiceRepository) Update(ctx context.Context, m *BillingInvoice) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE "billing"."invoices" SET "status" = $1 WHERE "id" = $2`, m.Status, m.ID)
+
+	return err
+}
+
+// Delete deletes the row of invoices with the primary key.
+func (r *BillingInvoiceRepository) Delete(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM "billing"."invoices" WHERE "id" = $1`, id)
+
+	return err
+}
+
+// Upsert inserts the row into invoices, or updates the row with the same primary key.
+func (r *BillingInvoiceRepository) Upsert(ctx context.Context, m *BillingInvoice) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO "billing"."invoices" ("id", "status") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "status" = EXCLUDED."status"`, m.ID, m.Status)
+
+	return err
+}
+
+// users
+//
+// primary key: (id)
+type Customer struct {
+	// users.id
+	ID int
+}
+
+// PrimaryKey returns the column names of the primary key of users.
+func (Customer) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// CustomerColumn is a column name of users.
+type CustomerColumn string
+
+// Column names of users.
+const (
+	CustomerColumnID CustomerColumn = "id"
+)
+
+// TableName returns the name of the table of Customer.
+func (Customer) TableName() string {
+	return "billing.users"
+}
+
+// Columns returns the column names of users in the order of the fields.
+func (Customer) Columns() []string {
+	return []string{"id"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Customer) ScanDest() []any {
+	return []any{&m.ID}
+}
+
+// CustomerRepository provides CRUD operations of users.
+type CustomerRepository struct {
+	db DBTX
+}
+
+// NewCustomerRepository returns a repository of users. db is either *sql.DB, *sql.Tx or *sql.Conn.
+func NewCustomerRepository(db DBTX) *CustomerRepository {
+	return &CustomerRepository{db: db}
+}
+
+// Insert inserts the row into users.
+func (r *CustomerRepository) Insert(ctx context.Context, m *Customer) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO "billing"."users" ("id") VALUES ($1)`, m.ID)
+
+	return err
+}
+
+// InsertBatch inserts the rows into users, 65535 rows per statement to stay within the 65535 bind parameters.
+// Call it in a transaction to insert all or none of the rows when more rows are given.
+func (r *CustomerRepository) InsertBatch(ctx context.Context, ms []*Customer) error {
+	for len(ms) > 0 {
+		batch := ms
+		if len(batch) > 65535 {
+			batch = batch[:65535]
+		}
+		ms = ms[len(batch):]
+
+		var b strings.Builder
+		b.WriteString(`INSERT INTO "billing"."users" ("id") VALUES `)
+		args := make([]any, 0, len(batch))
+		for i, m := range batch {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "($%d)", i+1)
+			args = append(args, m.ID)
+		}
+
+		if _, err := r.db.ExecContext(ctx, b.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByPK returns the row of users with the primary key. sql.ErrNoRows is returned when it does not exist.
+func (r *CustomerRepository) FindByPK(ctx context.Context, id int) (*Customer, error) {
+	var m Customer
+	if err := r.db.QueryRowContext(ctx, `SELECT "id" FROM "billing"."users" WHERE "id" = $1`, id).Scan(&m.ID); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Delete deletes the row of users with the primary key.
+func (r *CustomerRepository) Delete(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM "billing"."users" WHERE "id" = $1`, id)
+
+	return err
+}
+
+// Upsert inserts the row into users, or updates the row with the same primary key.
+func (r *CustomerRepository) Upsert(ctx context.Context, m *Customer) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO "billing"."users" ("id") VALUES ($1) ON CONFLICT ("id") DO NOTHING`, m.ID)
+
+	return err
+}
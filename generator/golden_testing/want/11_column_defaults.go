@@ -0,0 +1,221 @@
+package pkgname
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+// DBTX is the interface satisfied by *sql.DB, *sql.Tx and *sql.Conn.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// orders
+//
+// primary key: (id)
+type Order struct {
+	// orders.id
+	ID int64 `bun:"id,pk,autoincrement" gorm:"column:id;primaryKey;autoIncrement"`
+
+	// orders.price
+	Price int `bun:"price" gorm:"column:price"`
+
+	// orders.qty
+	Qty int `bun:"qty" gorm:"column:qty;default:1"`
+
+	// orders.total
+	Total int `bun:"total" gorm:"column:total;->"`
+
+	// orders.created_at
+	CreatedAt time.Time `bun:"created_at" gorm:"column:created_at;default:now()"`
+}
+
+// PrimaryKey returns the column names of the primary key of orders.
+func (Order) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// OrderColumn is a column name of orders.
+type OrderColumn string
+
+// Column names of orders.
+const (
+	OrderColumnID        OrderColumn = "id"
+	OrderColumnPrice     OrderColumn = "price"
+	OrderColumnQty       OrderColumn = "qty"
+	OrderColumnTotal     OrderColumn = "total"
+	OrderColumnCreatedAt OrderColumn = "created_at"
+)
+
+// TableName returns the name of the table of Order.
+func (Order) TableName() string {
+	return "orders"
+}
+
+// Columns returns the column names of orders in the order of the fields.
+func (Order) Columns() []string {
+	return []string{"id", "price", "qty", "total", "created_at"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Order) ScanDest() []any {
+	return []any{&m.ID, &m.Price, &m.Qty, &m.Total, &m.CreatedAt}
+}
+
+// OrderRepository provides CRUD operations of orders.
+type OrderRepository struct {
+	db DBTX
+}
+
+// NewOrderRepository returns a repository of orders. db is either *sql.DB, *sql.Tx or *sql.Conn.
+func NewOrderRepository(db DBTX) *OrderRepository {
+	return &OrderRepository{db: db}
+}
+
+// Insert inserts the row into orders. The columns filled by the database are scanned into m.
+func (r *OrderRepository) Insert(ctx context.Context, m *Order) error {
+	return r.db.QueryRowContext(ctx, `INSERT INTO "orders" ("price", "qty", "created_at") VALUES ($1, $2, $3) RETURNING "id", "total"`, m.Price, m.Qty, m.CreatedAt).Scan(&m.ID, &m.Total)
+}
+
+// InsertBatch inserts the rows into orders, 21845 rows per statement to stay within the 65535 bind parameters.
+// Call it in a transaction to insert all or none of the rows when more rows are given.
+func (r *OrderRepository) InsertBatch(ctx context.Context, ms []*Order) error {
+	for len(ms) > 0 {
+		batch := ms
+		if len(batch) > 21845 {
+			batch = batch[:21845]
+		}
+		ms = ms[len(batch):]
+
+		var b strings.Builder
+		b.WriteString(`INSERT INTO "orders" ("price", "qty", "created_at") VALUES `)
+		args := make([]any, 0, len(batch)*3)
+		for i, m := range batch {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			n := i * 3
+			fmt.Fprintf(&b, "($%d, $%d, $%d)", n+1, n+2, n+3)
+			args = append(args, m.Price, m.Qty, m.CreatedAt)
+		}
+
+		if _, err := r.db.ExecContext(ctx, b.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByPK returns the row of orders with the primary key. sql.ErrNoRows is returned when it does not exist.
+func (r *OrderRepository) FindByPK(ctx context.Context, id int64) (*Order, error) {
+	var m Order
+	if err := r.db.QueryRowContext(ctx, `SELECT "id", "price", "qty", "total", "created_at" FROM "orders" WHERE "id" = $1`, id).Scan(&m.ID, &m.Price, &m.Qty, &m.Total, &m.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Update updates the columns of the row of orders except for the primary key.
+func (r *OrderRepository) Update(ctx context.Context, m *Order) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE "orders" SET "price" = $1, "qty" = $2, "created_at" = $3 WHERE "id" = $4`, m.Price, m.Qty, m.CreatedAt, m.ID)
+
+	return err
+}
+
+// Delete deletes the row of orders with the primary key.
+func (r *OrderRepository) Delete(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM "orders" WHERE "id" = $1`, id)
+
+	return err
+}
+
+// Upsert inserts the row into orders, or updates the row with the same primary key.
+func (r *OrderRepository) Upsert(ctx context.Context, m *Order) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO "orders" ("id", "price", "qty", "created_at") OVERRIDING SYSTEM VALUE VALUES ($1, $2, $3, $4) ON CONFLICT ("id") DO UPDATE SET "price" = EXCLUDED."price", "qty" = EXCLUDED."qty", "created_at" = EXCLUDED."created_at"`, m.ID, m.Price, m.Qty, m.CreatedAt)
+
+	return err
+}
+
+// counters
+//
+// primary key: (id)
+type Counter struct {
+	// counters.id
+	ID int `bun:"id,pk,autoincrement" gorm:"column:id;primaryKey;autoIncrement"`
+}
+
+// PrimaryKey returns the column names of the primary key of counters.
+func (Counter) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// CounterColumn is a column name of counters.
+type CounterColumn string
+
+// Column names of counters.
+const (
+	CounterColumnID CounterColumn = "id"
+)
+
+// TableName returns the name of the table of Counter.
+func (Counter) TableName() string {
+	return "counters"
+}
+
+// Columns returns the column names of counters in the order of the fields.
+func (Counter) Columns() []string {
+	return []string{"id"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Counter) ScanDest() []any {
+	return []any{&m.ID}
+}
+
+// CounterRepository provides CRUD operations of counters.
+type CounterRepository struct {
+	db DBTX
+}
+
+// NewCounterRepository returns a repository of counters. db is either *sql.DB, *sql.Tx or *sql.Conn.
+func NewCounterRepository(db DBTX) *CounterRepository {
+	return &CounterRepository{db: db}
+}
+
+// Insert inserts the row into counters. The columns filled by the database are scanned into m.
+func (r *CounterRepository) Insert(ctx context.Context, m *Counter) error {
+	return r.db.QueryRowContext(ctx, `INSERT INTO "counters" DEFAULT VALUES RETURNING "id"`).Scan(&m.ID)
+}
+
+// FindByPK returns the row of counters with the primary key. sql.ErrNoRows is returned when it does not exist.
+func (r *CounterRepository) FindByPK(ctx context.Context, id int) (*Counter, error) {
+	var m Counter
+	if err := r.db.QueryRowContext(ctx, `SELECT "id" FROM "counters" WHERE "id" = $1`, id).Scan(&m.ID); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Delete deletes the row of counters with the primary key.
+func (r *CounterRepository) Delete(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM "counters" WHERE "id" = $1`, id)
+
+	return err
+}
+
+// Upsert inserts the row into counters, or updates the row with the same primary key.
+func (r *CounterRepository) Upsert(ctx context.Context, m *Counter) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO "counters" ("id") VALUES ($1) ON CONFLICT ("id") DO NOTHING`, m.ID)
+
+	return err
+}
@@ -0,0 +1,76 @@
+package pkgname
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Code generated by github.com/kmtym1998/chair. DO NOT EDIT.
+
+// products
+//
+// primary key: (id)
+type Product struct {
+	// products.id
+	ID int `db:"id"`
+
+	// products.grade
+	Grade rune `db:"grade"`
+
+	// products.sku
+	Sku string `db:"sku" validate:"max=8"`
+
+	// products.name
+	Name sql.NullString `db:"name" validate:"omitempty,max=255"`
+
+	// products.description
+	Description string `db:"description"`
+
+	// products.price
+	Price float64 `db:"price"`
+
+	// products.stock
+	Stock int64 `db:"stock"`
+
+	// products.weight
+	Weight float64 `db:"weight"`
+
+	// products.released_at
+	ReleasedAt time.Time `db:"released_at"`
+}
+
+// PrimaryKey returns the column names of the primary key of products.
+func (Product) PrimaryKey() []string {
+	return []string{"id"}
+}
+
+// ProductColumn is a column name of products.
+type ProductColumn string
+
+// Column names of products.
+const (
+	ProductColumnID          ProductColumn = "id"
+	ProductColumnGrade       ProductColumn = "grade"
+	ProductColumnSku         ProductColumn = "sku"
+	ProductColumnName        ProductColumn = "name"
+	ProductColumnDescription ProductColumn = "description"
+	ProductColumnPrice       ProductColumn = "price"
+	ProductColumnStock       ProductColumn = "stock"
+	ProductColumnWeight      ProductColumn = "weight"
+	ProductColumnReleasedAt  ProductColumn = "released_at"
+)
+
+// TableName returns the name of the table of Product.
+func (Product) TableName() string {
+	return "products"
+}
+
+// Columns returns the column names of products in the order of the fields.
+func (Product) Columns() []string {
+	return []string{"id", "grade", "sku", "name", "description", "price", "stock", "weight", "released_at"}
+}
+
+// ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.
+func (m *Product) ScanDest() []any {
+	return []any{&m.ID, &m.Grade, &m.Sku, &m.Name, &m.Description, &m.Price, &m.Stock, &m.Weight, &m.ReleasedAt}
+}
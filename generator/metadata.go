@@ -0,0 +1,88 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/kmtym1998/chair/generator/config"
+)
+
+// structFieldNames returns the names of the fields of the model, which must not be used as method names.
+func (g *Generator) structFieldNames(table Table) map[string]bool {
+	names := make(map[string]bool, len(table.Columns)+len(table.Relations))
+	for _, column := range table.Columns {
+		names[g.fieldName(table, column.Name)] = true
+	}
+
+	if g.config.EmitRelations {
+		for _, relation := range table.Relations {
+			names[relation.Name] = true
+		}
+	}
+
+	return names
+}
+
+// isQualified reports whether the name of the table is qualified by the schema, which is when more than one schema
+// is loaded or when the table is outside of the default schema unqualified names resolve to.
+func (g *Generator) isQualified(table Table) bool {
+	return table.Schema != "" && (g.multiSchema || table.Schema != config.DefaultPostgresSchema)
+}
+
+// qualifiedTableName returns the name of the table, qualified by the schema as isQualified decides.
+func (g *Generator) qualifiedTableName(table Table) string {
+	if g.isQualified(table) {
+		return table.Schema + "." + table.Name
+	}
+
+	return table.Name
+}
+
+// generateTableMetadata generates the column name constants of the table together with
+// TableName, Columns and ScanDest methods. Methods colliding with a field name are omitted.
+// The columns must be sorted in the order of the struct fields.
+func (g *Generator) generateTableMetadata(table Table) *jen.Statement {
+	modelName := g.structName(table)
+	columnTypeName := modelName + "Column"
+	fieldNames := g.structFieldNames(table)
+
+	constants := make([]jen.Code, len(table.Columns))
+	columnNames := make([]jen.Code, len(table.Columns))
+	for i, column := range table.Columns {
+		constants[i] = jen.Id(columnTypeName + g.fieldName(table, column.Name)).Id(columnTypeName).Op("=").Lit(column.Name)
+		columnNames[i] = jen.Lit(column.Name)
+	}
+
+	stmt := jen.Comment(fmt.Sprintf("%s is a column name of %s.", columnTypeName, table.Name)).Line().
+		Type().Id(columnTypeName).String().Line().Line()
+
+	// constants cannot be reassigned by the users of the package unlike the fields of a variable
+	stmt.Comment(fmt.Sprintf("Column names of %s.", table.Name)).Line().
+		Const().Defs(constants...)
+
+	if !fieldNames["TableName"] {
+		stmt.Line().Line().
+			Comment(fmt.Sprintf("TableName returns the name of the table of %s.", modelName)).Line().
+			Func().Params(jen.Id(modelName)).Id("TableName").Params().String().Block(
+			jen.Return(jen.Lit(g.qualifiedTableName(table))),
+		)
+	}
+
+	if !fieldNames["Columns"] {
+		stmt.Line().Line().
+			Comment(fmt.Sprintf("Columns returns the column names of %s in the order of the fields.", table.Name)).Line().
+			Func().Params(jen.Id(modelName)).Id("Columns").Params().Index().String().Block(
+			jen.Return(jen.Index().String().Values(columnNames...)),
+		)
+	}
+
+	if !fieldNames["ScanDest"] {
+		stmt.Line().Line().
+			Comment("ScanDest returns the pointers to the fields in the order of Columns to pass to rows.Scan.").Line().
+			Func().Params(jen.Id("m").Op("*").Id(modelName)).Id("ScanDest").Params().Index().Any().Block(
+			jen.Return(jen.Index().Any().Values(g.fieldRefs("m", table, table.Columns, true)...)),
+		)
+	}
+
+	return stmt
+}
@@ -0,0 +1,157 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/kmtym1998/chair/generator/config"
+)
+
+const (
+	gureguNullPkg = "github.com/guregu/null"
+	pgtypePkg     = "github.com/jackc/pgx/v5/pgtype"
+)
+
+// sqlNullTypes maps Go types to the nullable types of database/sql.
+var sqlNullTypes = map[string]string{
+	"string":    "NullString",
+	"int64":     "NullInt64",
+	"int32":     "NullInt32",
+	"int16":     "NullInt16",
+	"byte":      "NullByte",
+	"uint8":     "NullByte",
+	"float64":   "NullFloat64",
+	"bool":      "NullBool",
+	"time.Time": "NullTime",
+}
+
+// gureguNullTypes maps Go types to the types of github.com/guregu/null. Integers and floats are widened to 64 bits.
+var gureguNullTypes = map[string]string{
+	"string":    "String",
+	"int":       "Int",
+	"int8":      "Int",
+	"int16":     "Int",
+	"int32":     "Int",
+	"int64":     "Int",
+	"float32":   "Float",
+	"float64":   "Float",
+	"bool":      "Bool",
+	"time.Time": "Time",
+}
+
+// pgtypeDBTypes maps PostgreSQL data types to the types of github.com/jackc/pgx/v5/pgtype.
+var pgtypeDBTypes = map[string]string{
+	"smallint":                    "Int2",
+	"integer":                     "Int4",
+	"bigint":                      "Int8",
+	"real":                        "Float4",
+	"double precision":            "Float8",
+	"numeric":                     "Numeric",
+	"decimal":                     "Numeric",
+	"boolean":                     "Bool",
+	"text":                        "Text",
+	"character varying":           "Text",
+	"character":                   "Text",
+	"date":                        "Date",
+	"timestamp without time zone": "Timestamp",
+	"timestamp with time zone":    "Timestamptz",
+	"time without time zone":      "Time",
+	"interval":                    "Interval",
+	"uuid":                        "UUID",
+}
+
+// nullStyle returns the null style of the table, which is overridden by nullStyle.
+func (g *Generator) nullStyle(table Table) string {
+	if override, ok := g.tableOverride(table); ok && override.NullStyle != "" {
+		return override.NullStyle
+	}
+
+	return g.config.NullStyle
+}
+
+// styledNullableFieldType derives the type of the nullable column from the type of the non-null column with the style.
+// Nullable mappings declared in the config take precedence over the style.
+func (g *Generator) styledNullableFieldType(table Table, column Column, style string) (*jen.Statement, bool) {
+	dbType := column.Type
+	if column.Enum != "" {
+		dbType = column.Enum
+	}
+
+	for _, m := range g.config.Mappings {
+		if m.DBType == dbType && m.IsNullable && !m.IsArray && matchesSize(m, column) {
+			return qualType(m.GoPkg, m.GoType), true
+		}
+	}
+
+	goPkg, goType, ok := g.nonNullType(table, column)
+	if !ok {
+		return g.fallbackType(), false
+	}
+
+	return nullableType(style, column.Type, goPkg, goType), true
+}
+
+// nonNullType returns the package and the name of the Go type of the column as if it were NOT NULL.
+func (g *Generator) nonNullType(table Table, column Column) (string, string, bool) {
+	if column.Enum != "" {
+		if mapping, ok := g.findMappingByDBType(column.Enum, column, false); ok {
+			return mapping.GoPkg, mapping.GoType, true
+		}
+
+		if typeName, ok := g.enumTypeNames[columnEnumKey(table, column)]; ok {
+			return "", typeName, true
+		}
+	}
+
+	mapping, ok := g.findMappingByDBType(column.Type, column, false)
+	if !ok {
+		return "", "", false
+	}
+
+	return mapping.GoPkg, mapping.GoType, true
+}
+
+// nullableType returns the nullable type of the Go type in the style.
+// Types which can be nil, e.g. []byte, are returned as is since nil represents NULL.
+func nullableType(style, dbType, goPkg, goType string) *jen.Statement {
+	typeStmt := qualType(goPkg, goType)
+	if isNilable(goType) {
+		return typeStmt
+	}
+
+	// the qualified name without the import path, e.g. "time.Time"
+	name := goType
+	if goPkg != "" {
+		name = goPkg[strings.LastIndex(goPkg, "/")+1:] + "." + goType
+	}
+
+	switch style {
+	case config.NullStyleSQL:
+		if nullType, ok := sqlNullTypes[name]; ok {
+			return jen.Qual("database/sql", nullType)
+		}
+
+		return jen.Qual("database/sql", "Null").Types(typeStmt)
+	case config.NullStyleGeneric:
+		return jen.Qual("database/sql", "Null").Types(typeStmt)
+	case config.NullStyleGuregu:
+		if nullType, ok := gureguNullTypes[name]; ok {
+			return jen.Qual(gureguNullPkg, nullType)
+		}
+	case config.NullStylePgtype:
+		if pgType, ok := pgtypeDBTypes[dbType]; ok {
+			return jen.Qual(pgtypePkg, pgType)
+		}
+	}
+
+	return jen.Op("*").Add(typeStmt)
+}
+
+// isNilable reports whether the Go type is a slice, a map, a pointer or an interface.
+func isNilable(goType string) bool {
+	return strings.HasPrefix(goType, "[]") ||
+		strings.HasPrefix(goType, "map[") ||
+		strings.HasPrefix(goType, "*") ||
+		goType == "any" ||
+		goType == "interface{}"
+}
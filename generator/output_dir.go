@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/kmtym1998/chair/generator/config"
+)
+
+const (
+	// manifestFileName is the file in the output directory listing the generated files.
+	manifestFileName = ".chair-manifest"
+	// enumsFileName is the file holding all the enums in the output directory.
+	enumsFileName = "enums_gen.go"
+)
+
+// dirOutputs returns the files of the output directory: one file per table, the enums in enumsFileName,
+// the DBTX interface in dbtxFileName and the manifest.
+// Files listed in the previous manifest which are not generated anymore, e.g. files of dropped tables, are returned as stale.
+// Files not listed in the manifest are never touched.
+func (g *Generator) dirOutputs(tables []Table, enums []Enum) ([]output, []string, error) {
+	fileNames, err := g.tableFileNames(tables)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		outputs   []output
+		generated []string
+	)
+	add := func(name string, file *jen.File) error {
+		o, err := renderFile(filepath.Join(g.config.OutputDir, name), file)
+		if err != nil {
+			return err
+		}
+
+		outputs = append(outputs, o)
+		generated = append(generated, name)
+
+		return nil
+	}
+
+	if len(enums) > 0 {
+		file := newFile(g.config.PkgName)
+		for _, enum := range enums {
+			file.Add(g.generateEnum(enum, g.enumTypeNames[enumKey(enum.Schema, enum.Name)]))
+		}
+
+		if err := add(enumsFileName, file); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if g.emitsDBTX(tables) {
+		file := newFile(g.config.PkgName)
+		file.Add(generateDBTX())
+
+		if err := add(dbtxFileName, file); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for i, table := range tables {
+		if slices.Contains(generated, fileNames[i]) {
+			return nil, nil, fmt.Errorf("file name %s of table %s collides with another generated file", fileNames[i], table.Name)
+		}
+
+		file := newFile(g.config.PkgName)
+		g.generateTable(file, table)
+
+		if err := add(fileNames[i], file); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	previous, err := readManifest(g.config.OutputDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stalePaths []string
+	for _, name := range previous {
+		if !slices.Contains(generated, name) {
+			stalePaths = append(stalePaths, filepath.Join(g.config.OutputDir, name))
+		}
+	}
+
+	slices.Sort(generated)
+	outputs = append(outputs, output{
+		path:    filepath.Join(g.config.OutputDir, manifestFileName),
+		content: manifest(generated),
+	})
+
+	return outputs, stalePaths, nil
+}
+
+// tableFileNames returns the file name of each table by executing the file name pattern.
+// The default pattern is qualified with the schema when the tables of more than one schema share the directory.
+func (g *Generator) tableFileNames(tables []Table) ([]string, error) {
+	pattern := cmp.Or(g.config.FileNamePattern, config.DefaultFileNamePattern)
+	if pattern == config.DefaultFileNamePattern && g.multiSchema && g.config.SchemaLayout != config.SchemaLayoutPackage {
+		pattern = config.DefaultMultiSchemaFileNamePattern
+	}
+	tmpl, err := template.New("fileNamePattern").Funcs(templateFuncs).Option("missingkey=error").Parse(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse file name pattern: %w", err)
+	}
+
+	names := make([]string, len(tables))
+	for i, table := range tables {
+		var b strings.Builder
+		err := tmpl.Execute(&b, struct {
+			Schema    string
+			Name      string
+			ModelName string
+		}{
+			Schema:    table.Schema,
+			Name:      table.Name,
+			ModelName: g.structName(table),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute file name pattern for table %s: %w", table.Name, err)
+		}
+
+		name := b.String()
+		if filepath.Ext(name) != ".go" || strings.ContainsAny(name, `/\`) {
+			return nil, fmt.Errorf("invalid file name %q of table %s: file name must be a .go file in the output directory", name, table.Name)
+		}
+
+		names[i] = name
+	}
+
+	return names, nil
+}
+
+// readManifest returns the file names listed in the manifest of the directory. It returns nil when there is no manifest.
+func readManifest(dir string) ([]string, error) {
+	f, err := os.Open(filepath.Join(dir, manifestFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open manifest: %w", err)
+	}
+	defer f.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		// entries outside the directory are ignored so that an edited manifest cannot delete other files
+		if line == "" || strings.HasPrefix(line, "#") || strings.ContainsAny(line, `/\`) {
+			continue
+		}
+
+		names = append(names, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	return names, nil
+}
+
+func manifest(names []string) []byte {
+	var b strings.Builder
+	b.WriteString("# Code generated by github.com/kmtym1998/chair. DO NOT EDIT.\n")
+	b.WriteString("# Files generated into this directory. Files no longer generated are deleted on the next run.\n")
+	for _, name := range names {
+		b.WriteString(name + "\n")
+	}
+
+	return []byte(b.String())
+}
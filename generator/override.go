@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/kmtym1998/chair/generator/config"
+	"github.com/samber/lo"
+)
+
+// tableKeys returns the names of the table, or of its column when columnName is not empty, which overrides and
+// filters are matched against in the order of precedence: "schema.table(.column)" and then "table(.column)".
+func tableKeys(table Table, columnName string) []string {
+	key := table.Name
+	if columnName != "" {
+		key += "." + columnName
+	}
+
+	if table.Schema == "" {
+		return []string{key}
+	}
+
+	return []string{table.Schema + "." + key, key}
+}
+
+// findOverride returns the override of the first key found.
+func (g *Generator) findOverride(keys []string) (config.Override, bool) {
+	for _, key := range keys {
+		if override, ok := g.config.Overrides[key]; ok {
+			return override, true
+		}
+	}
+
+	return config.Override{}, false
+}
+
+// tableOverride returns the override of the table.
+func (g *Generator) tableOverride(table Table) (config.Override, bool) {
+	return g.findOverride(tableKeys(table, ""))
+}
+
+// columnOverride returns the override of the column of the table.
+func (g *Generator) columnOverride(table Table, columnName string) (config.Override, bool) {
+	return g.findOverride(tableKeys(table, columnName))
+}
+
+// structName returns the model name of the table, which is overridden by structName.
+// The name is prefixed by the schema when the tables of more than one schema are generated into one package.
+func (g *Generator) structName(table Table) string {
+	if override, ok := g.tableOverride(table); ok && override.StructName != "" {
+		return override.StructName
+	}
+
+	return g.schemaPrefix(table.Schema) + modelName(table.Name)
+}
+
+// schemaPrefix returns the prefix of the Go type names of the schema, e.g. "Billing" for "billing".
+// It is empty unless the tables of more than one schema are generated into one package.
+func (g *Generator) schemaPrefix(schema string) string {
+	if !g.multiSchema || g.config.SchemaLayout == config.SchemaLayoutPackage {
+		return ""
+	}
+
+	return Field(schema).ToUpperCamel().String()
+}
+
+// fieldName returns the struct field name of the column of the table, which is overridden by fieldName.
+func (g *Generator) fieldName(table Table, columnName string) string {
+	if override, ok := g.columnOverride(table, columnName); ok && override.FieldName != "" {
+		return override.FieldName
+	}
+
+	return Field(columnName).ToUpperCamel().String()
+}
+
+// applyOverrides removes the skipped tables and columns, with the keys and the relations referring to them,
+// and replaces the comments of the overrides.
+// Overrides matching no table or column are reported as warnings since they are likely typos.
+func (g *Generator) applyOverrides(tables []Table) ([]Table, error) {
+	if len(g.config.Overrides) == 0 {
+		return tables, nil
+	}
+
+	matched := make(map[string]bool, len(g.config.Overrides))
+	applied := make([]Table, 0, len(tables))
+	for _, table := range tables {
+		markMatched(matched, tableKeys(table, ""))
+		override, _ := g.tableOverride(table)
+		if override.Skip {
+			continue
+		}
+
+		if override.Comment != "" {
+			table.Comment = override.Comment
+		}
+
+		columns := make([]Column, 0, len(table.Columns))
+		for _, column := range table.Columns {
+			markMatched(matched, tableKeys(table, column.Name))
+			override, _ := g.columnOverride(table, column.Name)
+			if override.Skip {
+				if slices.Contains(table.PrimaryKey, column.Name) {
+					return nil, fmt.Errorf("failed to skip column %s.%s: primary key columns cannot be skipped", table.Name, column.Name)
+				}
+
+				continue
+			}
+
+			if override.Comment != "" {
+				column.Comment = override.Comment
+			}
+
+			columns = append(columns, column)
+		}
+		table.Columns = columns
+
+		applied = append(applied, table)
+	}
+
+	keys := lo.Keys(g.config.Overrides)
+	slices.Sort(keys)
+	for _, key := range keys {
+		if !matched[key] {
+			slog.Warn("override matches no table or column", "key", key)
+		}
+	}
+
+	return pruneReferences(applied), nil
+}
+
+func markMatched(matched map[string]bool, keys []string) {
+	for _, key := range keys {
+		matched[key] = true
+	}
+}
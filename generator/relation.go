@@ -0,0 +1,152 @@
+package generator
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+	"github.com/stoewer/go-strcase"
+)
+
+// resolveRelations classifies the foreign keys of the tables into relations.
+// A foreign key becomes a one_to_one relation when its columns are unique in the owning table, otherwise many_to_one.
+// The referenced table receives the inverse relation (one_to_one or one_to_many).
+// Foreign keys referencing a table which is not loaded are ignored. Tables of other schemas can be referenced when
+// they are loaded together.
+func (g *Generator) resolveRelations(tables []Table) []Table {
+	indexByName := make(map[string]int, len(tables))
+	for i, table := range tables {
+		indexByName[table.Schema+"."+table.Name] = i
+		tables[i].Relations = nil
+	}
+
+	for _, table := range tables {
+		for _, fk := range table.ForeignKeys {
+			refSchema := cmp.Or(fk.RefSchema, table.Schema)
+			refIndex, ok := indexByName[refSchema+"."+fk.RefTable]
+			if !ok {
+				continue
+			}
+
+			relType := RelationTypeManyToOne
+			inverseType := RelationTypeOneToMany
+			if table.IsUnique(fk.Columns...) {
+				relType = RelationTypeOneToOne
+				inverseType = RelationTypeOneToOne
+			}
+
+			ownerIndex := indexByName[table.Schema+"."+table.Name]
+			tables[ownerIndex].Relations = append(tables[ownerIndex].Relations, Relation{
+				Name:           ownerRelationName(fk),
+				Type:           relType,
+				ForeignKeyName: fk.Name,
+				Columns:        fk.Columns,
+				RefSchema:      refSchema,
+				RefTable:       fk.RefTable,
+				RefColumns:     fk.RefColumns,
+			})
+
+			tables[refIndex].Relations = append(tables[refIndex].Relations, Relation{
+				Name:           inverseRelationName(table, fk, inverseType),
+				Type:           inverseType,
+				ForeignKeyName: fk.Name,
+				Columns:        fk.RefColumns,
+				RefSchema:      table.Schema,
+				RefTable:       table.Name,
+				RefColumns:     fk.Columns,
+				IsInverse:      true,
+			})
+		}
+	}
+
+	for i := range tables {
+		tables[i].Relations = g.dedupeRelationNames(tables[i])
+	}
+
+	return tables
+}
+
+// refTable returns the referenced table, which is identified by the schema and the name.
+func (r Relation) refTable() Table {
+	return Table{Schema: r.RefSchema, Name: r.RefTable}
+}
+
+// ownerRelationName names the relation on the table holding the foreign key.
+// "author_id" referencing "users" becomes "Author", and composite keys fall back to the singular referenced table name.
+func ownerRelationName(fk ForeignKey) string {
+	if base, ok := foreignKeyColumnBase(fk); ok {
+		return Field(base).ToUpperCamel().String()
+	}
+
+	return Field(fk.RefTable).ToUpperCamel().ToSingular().String()
+}
+
+// inverseRelationName names the relation on the referenced table.
+// The name is prefixed with the foreign key column when the owner table references the same table more than once,
+// e.g. "sender_id" and "receiver_id" of "messages" become "SenderMessages" and "ReceiverMessages".
+func inverseRelationName(owner Table, fk ForeignKey, relType RelationType) string {
+	name := Field(owner.Name).ToUpperCamel().ToSingular()
+	if relType == RelationTypeOneToMany {
+		name = Field(pluralize.NewClient().Plural(name.String()))
+	}
+
+	var refCount int
+	for _, other := range owner.ForeignKeys {
+		if other.RefSchema == fk.RefSchema && other.RefTable == fk.RefTable {
+			refCount++
+		}
+	}
+
+	if base, ok := foreignKeyColumnBase(fk); ok && refCount > 1 {
+		return Field(base).ToUpperCamel().String() + name.String()
+	}
+
+	return name.String()
+}
+
+// foreignKeyColumnBase returns the single foreign key column name without its "_id" suffix.
+func foreignKeyColumnBase(fk ForeignKey) (string, bool) {
+	if len(fk.Columns) != 1 {
+		return "", false
+	}
+
+	snake := strcase.SnakeCase(fk.Columns[0])
+	base, ok := strings.CutSuffix(snake, "_id")
+	if !ok || base == "" {
+		return "", false
+	}
+
+	return base, true
+}
+
+// dedupeRelationNames renames relations colliding with a column field or another relation
+// by appending "By" and the relation columns, and then a sequence number if it still collides.
+func (g *Generator) dedupeRelationNames(table Table) []Relation {
+	if len(table.Relations) == 0 {
+		return nil
+	}
+
+	used := make([]string, 0, len(table.Columns)+len(table.Relations))
+	for _, column := range table.Columns {
+		used = append(used, g.fieldName(table, column.Name))
+	}
+
+	relations := make([]Relation, len(table.Relations))
+	for i, rel := range table.Relations {
+		if slices.Contains(used, rel.Name) {
+			rel.Name += "By" + Field(strings.Join(rel.Columns, "_")).ToUpperCamel().String()
+		}
+
+		base := rel.Name
+		for n := 2; slices.Contains(used, rel.Name); n++ {
+			rel.Name = base + strconv.Itoa(n)
+		}
+
+		used = append(used, rel.Name)
+		relations[i] = rel
+	}
+
+	return relations
+}
@@ -0,0 +1,497 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/stoewer/go-strcase"
+)
+
+// dbtxFileName is the file holding the DBTX interface in the output directory.
+const dbtxFileName = "dbtx_gen.go"
+
+// reservedParamNames are the identifiers used in the generated methods, which must not be used as parameter names.
+var reservedParamNames = map[string]bool{
+	"ctx":  true,
+	"r":    true,
+	"m":    true,
+	"ms":   true,
+	"b":    true,
+	"args": true,
+	"err":  true,
+}
+
+// generateDBTX generates the interface satisfied by *sql.DB, *sql.Tx and *sql.Conn, which the repositories take.
+func generateDBTX() *jen.Statement {
+	ctx := jen.Id("ctx").Qual("context", "Context")
+	query := jen.Id("query").String()
+	args := jen.Id("args").Op("...").Any()
+
+	return jen.Comment("DBTX is the interface satisfied by *sql.DB, *sql.Tx and *sql.Conn.").Line().
+		Type().Id("DBTX").Interface(
+		jen.Id("ExecContext").Params(ctx.Clone(), query.Clone(), args.Clone()).Params(jen.Qual("database/sql", "Result"), jen.Error()),
+		jen.Id("QueryContext").Params(ctx.Clone(), query.Clone(), args.Clone()).Params(jen.Op("*").Qual("database/sql", "Rows"), jen.Error()),
+		jen.Id("QueryRowContext").Params(ctx.Clone(), query.Clone(), args.Clone()).Op("*").Qual("database/sql", "Row"),
+	)
+}
+
+// hasRepository reports whether a repository is generated for the table.
+// Repositories are generated for writable tables with columns.
+func hasRepository(table Table) bool {
+	return !table.IsReadOnly() && len(table.Columns) > 0
+}
+
+// generateRepository generates a repository of the table with Insert, InsertBatch, FindByPK, Update, Delete and Upsert.
+// The methods using the primary key are generated only for tables with a primary key.
+// Columns which cannot be written are excluded from the writes, and Insert leaves the columns filled by the database
+// to it, scanning them back into the model where RETURNING is supported.
+func (g *Generator) generateRepository(table Table) *jen.Statement {
+	modelName := g.structName(table)
+	repoName := modelName + "Repository"
+
+	columns := slices.Clone(table.Columns)
+	slices.SortStableFunc(columns, func(a, b Column) int {
+		return a.OrderAsc - b.OrderAsc
+	})
+
+	var pkColumns, valueColumns, insertColumns, returningColumns, upsertColumns []Column
+	for _, column := range columns {
+		isPrimaryKey := slices.Contains(table.PrimaryKey, column.Name)
+		if isPrimaryKey {
+			pkColumns = append(pkColumns, column)
+		} else if column.IsWritable() {
+			valueColumns = append(valueColumns, column)
+		}
+
+		if g.filledOnInsert(column) {
+			returningColumns = append(returningColumns, column)
+		} else {
+			insertColumns = append(insertColumns, column)
+		}
+
+		// the primary key is written to find the conflicting row even if it is an identity column
+		if isPrimaryKey || column.IsWritable() {
+			upsertColumns = append(upsertColumns, column)
+		}
+	}
+	// the primary key in the order of the constraint
+	slices.SortStableFunc(pkColumns, func(a, b Column) int {
+		return slices.Index(table.PrimaryKey, a.Name) - slices.Index(table.PrimaryKey, b.Name)
+	})
+
+	stmt := jen.Comment(fmt.Sprintf("%s provides CRUD operations of %s.", repoName, table.Name)).Line().
+		Type().Id(repoName).Struct(jen.Id("db").Id("DBTX")).Line().Line()
+
+	stmt.Comment(fmt.Sprintf("New%s returns a repository of %s. db is either *sql.DB, *sql.Tx or *sql.Conn.", repoName, table.Name)).Line().
+		Func().Id("New" + repoName).Params(jen.Id("db").Id("DBTX")).Op("*").Id(repoName).Block(
+		jen.Return(jen.Op("&").Id(repoName).Values(jen.Dict{jen.Id("db"): jen.Id("db")})),
+	).Line().Line()
+
+	receiver := jen.Id("r").Op("*").Id(repoName)
+	ctx := jen.Id("ctx").Qual("context", "Context")
+
+	stmt.Add(g.generateInsert(table, modelName, receiver, insertColumns, returningColumns)).Line().Line()
+
+	if len(insertColumns) > 0 {
+		stmt.Add(g.generateInsertBatch(table, modelName, receiver, insertColumns)).Line().Line()
+	}
+
+	if len(pkColumns) == 0 {
+		return stmt
+	}
+
+	pkParams := make([]jen.Code, len(pkColumns))
+	pkArgs := make([]jen.Code, len(pkColumns))
+	for i, column := range pkColumns {
+		typeStmt, _ := g.fieldType(table, column)
+		pkParams[i] = jen.Id(paramName(column.Name)).Add(typeStmt)
+		pkArgs[i] = jen.Id(paramName(column.Name))
+	}
+
+	selectQuery := fmt.Sprintf(
+		"SELECT %s FROM %s WHERE %s",
+		strings.Join(g.quoteColumns(columns), ", "),
+		g.tableIdent(table),
+		g.conditions(pkColumns, 0),
+	)
+	stmt.Comment(fmt.Sprintf("FindByPK returns the row of %s with the primary key. sql.ErrNoRows is returned when it does not exist.", table.Name)).Line().
+		Func().Params(receiver.Clone()).Id("FindByPK").Params(append([]jen.Code{ctx.Clone()}, pkParams...)...).Params(jen.Op("*").Id(modelName), jen.Error()).Block(
+		jen.Var().Id("m").Id(modelName),
+		jen.If(
+			jen.Err().Op(":=").Id("r").Dot("db").Dot("QueryRowContext").Call(
+				append([]jen.Code{jen.Id("ctx"), queryLit(selectQuery)}, pkArgs...)...,
+			).Dot("Scan").Call(g.fieldRefs("m", table, columns, true)...),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Line(),
+		jen.Return(jen.Op("&").Id("m"), jen.Nil()),
+	).Line().Line()
+
+	if len(valueColumns) > 0 {
+		sets := make([]string, len(valueColumns))
+		for i, column := range valueColumns {
+			sets[i] = g.quoteIdent(column.Name) + " = " + g.placeholder(i+1)
+		}
+		updateQuery := fmt.Sprintf(
+			"UPDATE %s SET %s WHERE %s",
+			g.tableIdent(table),
+			strings.Join(sets, ", "),
+			g.conditions(pkColumns, len(valueColumns)),
+		)
+		stmt.Comment(fmt.Sprintf("Update updates the columns of the row of %s except for the primary key.", table.Name)).Line().
+			Func().Params(receiver.Clone()).Id("Update").Params(ctx.Clone(), jen.Id("m").Op("*").Id(modelName)).Error().Block(
+			jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("r").Dot("db").Dot("ExecContext").Call(
+				append(append([]jen.Code{jen.Id("ctx"), queryLit(updateQuery)}, g.fieldRefs("m", table, valueColumns, false)...), g.fieldRefs("m", table, pkColumns, false)...)...,
+			),
+			jen.Line(),
+			jen.Return(jen.Err()),
+		).Line().Line()
+	}
+
+	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE %s", g.tableIdent(table), g.conditions(pkColumns, 0))
+	stmt.Comment(fmt.Sprintf("Delete deletes the row of %s with the primary key.", table.Name)).Line().
+		Func().Params(receiver.Clone()).Id("Delete").Params(append([]jen.Code{ctx.Clone()}, pkParams...)...).Error().Block(
+		jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("r").Dot("db").Dot("ExecContext").Call(
+			append([]jen.Code{jen.Id("ctx"), queryLit(deleteQuery)}, pkArgs...)...,
+		),
+		jen.Line(),
+		jen.Return(jen.Err()),
+	).Line().Line()
+
+	upsertQuery := g.insertQuery(table, upsertColumns) + g.overridingClause(upsertColumns) + g.valuesClause(upsertColumns, 0) + " " + g.upsertClause(pkColumns, valueColumns)
+	stmt.Comment(fmt.Sprintf("Upsert inserts the row into %s, or updates the row with the same primary key.", table.Name)).Line().
+		Func().Params(receiver.Clone()).Id("Upsert").Params(ctx.Clone(), jen.Id("m").Op("*").Id(modelName)).Error().Block(
+		jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("r").Dot("db").Dot("ExecContext").Call(
+			append([]jen.Code{jen.Id("ctx"), queryLit(upsertQuery)}, g.fieldRefs("m", table, upsertColumns, false)...)...,
+		),
+		jen.Line(),
+		jen.Return(jen.Err()),
+	)
+
+	return stmt
+}
+
+// filledOnInsert reports whether Insert leaves the column to the database: columns which cannot be written, identity
+// and serial columns, and the columns with a default when omitDefaultsOnInsert is set.
+func (g *Generator) filledOnInsert(column Column) bool {
+	return !column.IsWritable() || column.IsAutoIncrement() || (g.config.OmitDefaultsOnInsert && column.HasDefault())
+}
+
+// generateInsert generates a method inserting the row. The returning columns are scanned back into the model
+// where RETURNING is supported. On MySQL, only the AUTO_INCREMENT column is read back by LastInsertId.
+func (g *Generator) generateInsert(table Table, modelName string, receiver *jen.Statement, columns, returningColumns []Column) *jen.Statement {
+	query := g.insertQuery(table, columns) + g.valuesClause(columns, 0)
+	if len(columns) == 0 {
+		query = g.defaultValuesQuery(table)
+	}
+	args := append([]jen.Code{jen.Id("ctx"), nil}, g.fieldRefs("m", table, columns, false)...)
+
+	comment := fmt.Sprintf("Insert inserts the row into %s.", table.Name)
+	var body []jen.Code
+	autoIncrement, hasAutoIncrement := g.lastInsertIDColumn(table, returningColumns)
+	switch {
+	case len(returningColumns) > 0 && g.dialect != DialectMySQL:
+		comment += " The columns filled by the database are scanned into m."
+		args[1] = queryLit(query + " RETURNING " + strings.Join(g.quoteColumns(returningColumns), ", "))
+		body = []jen.Code{
+			jen.Return(jen.Id("r").Dot("db").Dot("QueryRowContext").Call(args...).Dot("Scan").Call(g.fieldRefs("m", table, returningColumns, true)...)),
+		}
+	case hasAutoIncrement:
+		lastID := jen.Id("lastID")
+		// LastInsertId returns int64, which is converted to the type of the field
+		if fieldType, _ := g.fieldType(table, autoIncrement); fmt.Sprintf("%#v", fieldType) != "int64" {
+			lastID = fieldType.Call(lastID)
+		}
+		comment += fmt.Sprintf(" The AUTO_INCREMENT value of %s is set to m.", autoIncrement.Name)
+		args[1] = queryLit(query)
+		body = []jen.Code{
+			jen.List(jen.Id("res"), jen.Err()).Op(":=").Id("r").Dot("db").Dot("ExecContext").Call(args...),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+			jen.Line(),
+			jen.List(jen.Id("lastID"), jen.Err()).Op(":=").Id("res").Dot("LastInsertId").Call(),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+			jen.Id("m").Dot(g.fieldName(table, autoIncrement.Name)).Op("=").Add(lastID),
+			jen.Line(),
+			jen.Return(jen.Nil()),
+		}
+	default:
+		args[1] = queryLit(query)
+		body = []jen.Code{
+			jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("r").Dot("db").Dot("ExecContext").Call(args...),
+			jen.Line(),
+			jen.Return(jen.Err()),
+		}
+	}
+
+	return jen.Comment(comment).Line().
+		Func().Params(receiver.Clone()).Id("Insert").Params(jen.Id("ctx").Qual("context", "Context"), jen.Id("m").Op("*").Id(modelName)).Error().Block(body...)
+}
+
+// lastInsertIDColumn returns the AUTO_INCREMENT column of MySQL among the columns filled on insert, whose value
+// LastInsertId returns. It is found only when the field is of an integer type which the value can be converted to.
+func (g *Generator) lastInsertIDColumn(table Table, returningColumns []Column) (Column, bool) {
+	if g.dialect != DialectMySQL {
+		return Column{}, false
+	}
+
+	for _, column := range returningColumns {
+		if !column.IsIdentity() {
+			continue
+		}
+
+		fieldType, _ := g.fieldType(table, column)
+		if integerTypes[fmt.Sprintf("%#v", fieldType)] {
+			return column, true
+		}
+	}
+
+	return Column{}, false
+}
+
+// integerTypes are the Go types LastInsertId can be converted to.
+var integerTypes = map[string]bool{
+	"int":    true,
+	"int8":   true,
+	"int16":  true,
+	"int32":  true,
+	"int64":  true,
+	"uint":   true,
+	"uint8":  true,
+	"uint16": true,
+	"uint32": true,
+	"uint64": true,
+}
+
+// maxBindParams returns the number of bind parameters a statement can have: 65535 for PostgreSQL and MySQL, and 999
+// for SQLite, which is the limit before SQLite 3.32 raised it to 32766.
+func (g *Generator) maxBindParams() int {
+	if g.dialect == DialectSQLite {
+		return 999
+	}
+
+	return 65535
+}
+
+// generateInsertBatch generates a method inserting the rows with as few statements as the bind parameter limit
+// of the database allows.
+func (g *Generator) generateInsertBatch(table Table, modelName string, receiver *jen.Statement, columns []Column) *jen.Statement {
+	var valuesStmt *jen.Statement
+	switch {
+	case g.dialect != DialectPostgres:
+		valuesStmt = jen.Id("b").Dot("WriteString").Call(jen.Lit(g.placeholderTuple(columns, 0)))
+	case len(columns) == 1:
+		valuesStmt = jen.Qual("fmt", "Fprintf").Call(jen.Op("&").Id("b"), jen.Lit("($%d)"), jen.Id("i").Op("+").Lit(1))
+	default:
+		format := make([]string, len(columns))
+		args := []jen.Code{jen.Op("&").Id("b"), nil}
+		for i := range columns {
+			format[i] = "$%d"
+			args = append(args, jen.Id("n").Op("+").Lit(i+1))
+		}
+		args[1] = jen.Lit("(" + strings.Join(format, ", ") + ")")
+
+		valuesStmt = jen.Id("n").Op(":=").Id("i").Op("*").Lit(len(columns)).Line().
+			Qual("fmt", "Fprintf").Call(args...)
+	}
+
+	capacity := jen.Len(jen.Id("batch"))
+	if len(columns) > 1 {
+		capacity.Op("*").Lit(len(columns))
+	}
+
+	maxRows := g.maxBindParams() / len(columns)
+
+	return jen.Comment(fmt.Sprintf("InsertBatch inserts the rows into %s, %d rows per statement to stay within the %d bind parameters.", table.Name, maxRows, g.maxBindParams())).Line().
+		Comment("Call it in a transaction to insert all or none of the rows when more rows are given.").Line().
+		Func().Params(receiver.Clone()).Id("InsertBatch").Params(
+		jen.Id("ctx").Qual("context", "Context"),
+		jen.Id("ms").Index().Op("*").Id(modelName),
+	).Error().Block(
+		jen.For(jen.Len(jen.Id("ms")).Op(">").Lit(0)).Block(
+			jen.Id("batch").Op(":=").Id("ms"),
+			jen.If(jen.Len(jen.Id("batch")).Op(">").Lit(maxRows)).Block(
+				jen.Id("batch").Op("=").Id("batch").Index(jen.Empty(), jen.Lit(maxRows)),
+			),
+			jen.Id("ms").Op("=").Id("ms").Index(jen.Len(jen.Id("batch")), jen.Empty()),
+			jen.Line(),
+			jen.Var().Id("b").Qual("strings", "Builder"),
+			jen.Id("b").Dot("WriteString").Call(queryLit(g.insertQuery(table, columns)+"VALUES ")),
+			jen.Id("args").Op(":=").Make(jen.Index().Any(), jen.Lit(0), capacity),
+			jen.For(jen.List(jen.Id("i"), jen.Id("m")).Op(":=").Range().Id("batch")).Block(
+				jen.If(jen.Id("i").Op(">").Lit(0)).Block(jen.Id("b").Dot("WriteString").Call(jen.Lit(", "))),
+				valuesStmt,
+				jen.Id("args").Op("=").Append(append([]jen.Code{jen.Id("args")}, g.fieldRefs("m", table, columns, false)...)...),
+			),
+			jen.Line(),
+			jen.If(
+				jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("r").Dot("db").Dot("ExecContext").Call(jen.Id("ctx"), jen.Id("b").Dot("String").Call(), jen.Id("args").Op("...")),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(jen.Err())),
+		),
+		jen.Line(),
+		jen.Return(jen.Nil()),
+	)
+}
+
+// insertQuery returns the INSERT statement up to the column list followed by a space.
+func (g *Generator) insertQuery(table Table, columns []Column) string {
+	return fmt.Sprintf("INSERT INTO %s (%s) ", g.tableIdent(table), strings.Join(g.quoteColumns(columns), ", "))
+}
+
+// defaultValuesQuery returns the INSERT statement of a row with the defaults of all columns.
+func (g *Generator) defaultValuesQuery(table Table) string {
+	if g.dialect == DialectMySQL {
+		return fmt.Sprintf("INSERT INTO %s () VALUES ()", g.tableIdent(table))
+	}
+
+	return fmt.Sprintf("INSERT INTO %s DEFAULT VALUES", g.tableIdent(table))
+}
+
+// overridingClause returns OVERRIDING SYSTEM VALUE followed by a space when any of the columns is a GENERATED ALWAYS
+// identity column, which PostgreSQL requires to write it.
+func (g *Generator) overridingClause(columns []Column) string {
+	if g.dialect != DialectPostgres {
+		return ""
+	}
+
+	for _, column := range columns {
+		if column.IdentityGeneration == IdentityGenerationAlways {
+			return "OVERRIDING SYSTEM VALUE "
+		}
+	}
+
+	return ""
+}
+
+// valuesClause returns the VALUES clause with the placeholders of the columns numbered from offset+1.
+func (g *Generator) valuesClause(columns []Column, offset int) string {
+	return "VALUES " + g.placeholderTuple(columns, offset)
+}
+
+// placeholderTuple returns the parenthesized placeholders of the columns numbered from offset+1, e.g. "($1, $2)".
+func (g *Generator) placeholderTuple(columns []Column, offset int) string {
+	placeholders := make([]string, len(columns))
+	for i := range columns {
+		placeholders[i] = g.placeholder(offset + i + 1)
+	}
+
+	return "(" + strings.Join(placeholders, ", ") + ")"
+}
+
+// conditions returns the conditions matching the columns with the placeholders numbered from offset+1.
+func (g *Generator) conditions(columns []Column, offset int) string {
+	conditions := make([]string, len(columns))
+	for i, column := range columns {
+		conditions[i] = g.quoteIdent(column.Name) + " = " + g.placeholder(offset+i+1)
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+// upsertClause returns the clause updating the value columns on a conflict of the primary key.
+func (g *Generator) upsertClause(pkColumns, valueColumns []Column) string {
+	if g.dialect == DialectMySQL {
+		if len(valueColumns) == 0 {
+			// a no-op assignment since MySQL has no DO NOTHING
+			name := g.quoteIdent(pkColumns[0].Name)
+			return "ON DUPLICATE KEY UPDATE " + name + " = " + name
+		}
+
+		sets := make([]string, len(valueColumns))
+		for i, column := range valueColumns {
+			name := g.quoteIdent(column.Name)
+			sets[i] = name + " = VALUES(" + name + ")"
+		}
+
+		return "ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	}
+
+	conflict := "ON CONFLICT (" + strings.Join(g.quoteColumns(pkColumns), ", ") + ")"
+	if len(valueColumns) == 0 {
+		return conflict + " DO NOTHING"
+	}
+
+	sets := make([]string, len(valueColumns))
+	for i, column := range valueColumns {
+		name := g.quoteIdent(column.Name)
+		sets[i] = name + " = EXCLUDED." + name
+	}
+
+	return conflict + " DO UPDATE SET " + strings.Join(sets, ", ")
+}
+
+// placeholder returns the n-th (1-based) bind parameter of the dialect.
+func (g *Generator) placeholder(n int) string {
+	if g.dialect == DialectPostgres {
+		return "$" + strconv.Itoa(n)
+	}
+
+	return "?"
+}
+
+// quoteIdent quotes the identifier so that reserved words and mixed case names can be used.
+func (g *Generator) quoteIdent(name string) string {
+	if g.dialect == DialectMySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// tableIdent returns the quoted name of the table, qualified by the schema as qualifiedTableName is.
+func (g *Generator) tableIdent(table Table) string {
+	if g.isQualified(table) {
+		return g.quoteIdent(table.Schema) + "." + g.quoteIdent(table.Name)
+	}
+
+	return g.quoteIdent(table.Name)
+}
+
+func (g *Generator) quoteColumns(columns []Column) []string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = g.quoteIdent(column.Name)
+	}
+
+	return names
+}
+
+// queryLit returns the query as a raw string literal when possible for readability.
+func queryLit(query string) *jen.Statement {
+	if strings.Contains(query, "`") {
+		return jen.Lit(query)
+	}
+
+	return jen.Op("`" + query + "`")
+}
+
+// fieldRefs returns the fields of the model for the columns of the table, or their addresses to scan into.
+func (g *Generator) fieldRefs(model string, table Table, columns []Column, address bool) []jen.Code {
+	refs := make([]jen.Code, len(columns))
+	for i, column := range columns {
+		ref := jen.Id(model).Dot(g.fieldName(table, column.Name))
+		if address {
+			ref = jen.Op("&").Add(ref)
+		}
+		refs[i] = ref
+	}
+
+	return refs
+}
+
+// paramName returns the parameter name for the column, e.g. "user_id" -> "userID".
+func paramName(columnName string) string {
+	words := strings.Split(strcase.SnakeCase(columnName), "_")
+	name := words[0] + Field(strings.Join(words[1:], "_")).ToUpperCamel().String()
+	if token.IsKeyword(name) || reservedParamNames[name] {
+		return name + "_"
+	}
+
+	return name
+}
@@ -1,11 +1,41 @@
 package generator
 
-import "context"
+import (
+	"context"
+	"slices"
+	"strings"
+)
 
 type Table struct {
-	Name    string
-	Comment string
-	Columns []Column
+	// Schema is the schema (namespace) of the table. Empty if the database has no schemas, e.g. SQLite.
+	Schema            string
+	Name              string
+	Kind              TableKind
+	Comment           string
+	Columns           []Column
+	PrimaryKey        []string
+	UniqueConstraints []UniqueConstraint
+	Indexes           []Index
+	ForeignKeys       []ForeignKey
+	// Relations is resolved from ForeignKeys of all loaded tables by Generator.
+	Relations []Relation
+}
+
+type TableKind string
+
+const (
+	TableKindTable            TableKind = "table"
+	TableKindView             TableKind = "view"
+	TableKindMaterializedView TableKind = "materialized_view"
+)
+
+func (k TableKind) String() string {
+	return string(k)
+}
+
+// IsReadOnly reports whether the table is a view or a materialized view.
+func (t Table) IsReadOnly() bool {
+	return t.Kind == TableKindView || t.Kind == TableKindMaterializedView
 }
 
 type Column struct {
@@ -14,6 +44,181 @@ type Column struct {
 	Type       string
 	IsNullable bool
 	OrderAsc   int
+	// Enum is the name of the enum type of the column, or of its elements for an array column.
+	// Empty if the column is not an enum.
+	Enum string
+	// EnumSchema is the schema of Enum, which may differ from the schema of the table.
+	// Empty when the enum is in the schema of the table or the database has no schemas.
+	EnumSchema string
+	// ArrayDims is the number of array dimensions. 0 if the column is not an array.
+	ArrayDims int
+	// ElemType is the type of the array elements. Empty if the column is not an array.
+	ElemType string
+	// Default is the default expression of the column, e.g. "now()" or "nextval('users_id_seq'::regclass)" for a serial
+	// column. Empty if the column has no default.
+	Default string
+	// IdentityGeneration is set for identity columns. Empty if the column is not an identity column.
+	IdentityGeneration IdentityGeneration
+	// IsGenerated is true for generated columns, whose values are computed from the other columns.
+	IsGenerated bool
+	// Length is the maximum length of character and bit string columns as reported by the database, e.g. 255 for
+	// varchar(255), or 65535 for text of MySQL. 0 if the length is unlimited or the column is of another type.
+	Length int
+	// Precision is the declared precision of numeric (decimal) columns, e.g. 12 for numeric(12,2).
+	// 0 if the precision is not declared or the column is of another type.
+	Precision int
+	// Scale is the declared scale of numeric (decimal) columns, e.g. 2 for numeric(12,2). It is meaningful only when
+	// Precision is set.
+	Scale int
+	// DatetimePrecision is the fractional seconds precision of time, timestamp and interval columns, e.g. 3 for
+	// timestamp(3). When not declared, it is the default of the database (6 for PostgreSQL and 0 for MySQL).
+	// 0 for date columns and columns of other types.
+	DatetimePrecision int
+}
+
+// IsIdentity reports whether the column is an identity column.
+func (c Column) IsIdentity() bool {
+	return c.IdentityGeneration != ""
+}
+
+// IsSerial reports whether the default of the column is the next value of a sequence as serial columns have.
+func (c Column) IsSerial() bool {
+	return strings.HasPrefix(c.Default, "nextval(")
+}
+
+// IsAutoIncrement reports whether the database numbers the column by a sequence, i.e. an identity or a serial column.
+func (c Column) IsAutoIncrement() bool {
+	return c.IsIdentity() || c.IsSerial()
+}
+
+// HasDefault reports whether the database fills the column when it is omitted from an INSERT.
+func (c Column) HasDefault() bool {
+	return c.Default != "" || c.IsIdentity() || c.IsGenerated
+}
+
+// IsWritable reports whether the column can be written by INSERT and UPDATE without overriding the database.
+// Generated columns and GENERATED ALWAYS identity columns cannot be written.
+func (c Column) IsWritable() bool {
+	return !c.IsGenerated && c.IdentityGeneration != IdentityGenerationAlways
+}
+
+type IdentityGeneration string
+
+const (
+	IdentityGenerationAlways    IdentityGeneration = "ALWAYS"
+	IdentityGenerationByDefault IdentityGeneration = "BY DEFAULT"
+)
+
+func (i IdentityGeneration) String() string {
+	return string(i)
+}
+
+type Enum struct {
+	// Schema is the schema (namespace) of the enum. Empty if the database has no schemas.
+	Schema  string
+	Name    string
+	Comment string
+	Values  []string
+}
+
+type UniqueConstraint struct {
+	Name    string
+	Columns []string
+}
+
+type Index struct {
+	Name          string
+	Columns       []string
+	IsUnique      bool
+	IsPrimary     bool
+	IsPartial     bool
+	HasExpression bool
+	Definition    string
+}
+
+type ForeignKey struct {
+	Name    string
+	Columns []string
+	// RefSchema is the schema of RefTable. It is empty when the loader does not report it,
+	// in which case RefTable is in the schema of the table.
+	RefSchema  string
+	RefTable   string
+	RefColumns []string
+	// OnDelete and OnUpdate are empty when the loader does not report them.
+	OnDelete            ForeignKeyAction
+	OnUpdate            ForeignKeyAction
+	IsDeferrable        bool
+	IsInitiallyDeferred bool
+}
+
+// ForeignKeyAction is the action taken on the referencing rows when the referenced row is deleted or updated.
+type ForeignKeyAction string
+
+const (
+	ForeignKeyActionNoAction   ForeignKeyAction = "NO ACTION"
+	ForeignKeyActionRestrict   ForeignKeyAction = "RESTRICT"
+	ForeignKeyActionCascade    ForeignKeyAction = "CASCADE"
+	ForeignKeyActionSetNull    ForeignKeyAction = "SET NULL"
+	ForeignKeyActionSetDefault ForeignKeyAction = "SET DEFAULT"
+)
+
+func (a ForeignKeyAction) String() string {
+	return string(a)
+}
+
+// Relation is an association from the table to RefTable.
+// Columns belong to the table and RefColumns belong to RefTable.
+// IsInverse is true when the foreign key is defined on RefTable rather than on the table.
+type Relation struct {
+	Name           string
+	Type           RelationType
+	ForeignKeyName string
+	Columns        []string
+	// RefSchema is the schema of RefTable.
+	RefSchema  string
+	RefTable   string
+	RefColumns []string
+	IsInverse  bool
+}
+
+// IsUnique reports whether the given set of columns is guaranteed to be unique in the table,
+// i.e. it matches the primary key, a unique constraint or a non-partial unique index regardless of column order.
+func (t Table) IsUnique(columns ...string) bool {
+	sameSet := func(a, b []string) bool {
+		if len(a) != len(b) {
+			return false
+		}
+
+		for _, v := range a {
+			if !slices.Contains(b, v) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if len(columns) == 0 {
+		return false
+	}
+
+	if sameSet(t.PrimaryKey, columns) {
+		return true
+	}
+
+	for _, u := range t.UniqueConstraints {
+		if sameSet(u.Columns, columns) {
+			return true
+		}
+	}
+
+	for _, idx := range t.Indexes {
+		if idx.IsUnique && !idx.IsPartial && !idx.HasExpression && sameSet(idx.Columns, columns) {
+			return true
+		}
+	}
+
+	return false
 }
 
 type RelationType string
@@ -31,3 +236,23 @@ func (r RelationType) String() string {
 type SchemaLoader interface {
 	LoadTableSchemas(ctx context.Context) ([]Table, error)
 }
+
+// EnumLoader is implemented by a SchemaLoader which supports enum types.
+type EnumLoader interface {
+	LoadEnums(ctx context.Context) ([]Enum, error)
+}
+
+// Dialect is the SQL dialect of the database, which decides the SQL of the generated repositories.
+type Dialect string
+
+const (
+	DialectPostgres Dialect = "postgres"
+	DialectMySQL    Dialect = "mysql"
+	DialectSQLite   Dialect = "sqlite"
+)
+
+// DialectLoader is implemented by a SchemaLoader which knows its SQL dialect.
+// DialectPostgres is assumed for a SchemaLoader which does not implement it.
+type DialectLoader interface {
+	Dialect() Dialect
+}
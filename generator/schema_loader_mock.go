@@ -6,6 +6,7 @@ import (
 
 type SchemaLoaderMock struct {
 	returnTables []Table
+	returnEnums  []Enum
 	returnError  error
 }
 
@@ -14,6 +15,7 @@ func NewSchemaLoaderMock() SchemaLoader {
 		returnTables: []Table{
 			{
 				Name: "character_types",
+				Kind: TableKindTable,
 				Columns: []Column{
 					{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
 					{Name: "character_value_nullable", Type: "character", IsNullable: true, OrderAsc: 2},
@@ -23,9 +25,14 @@ func NewSchemaLoaderMock() SchemaLoader {
 					{Name: "character_varying_value", Type: "character varying", IsNullable: false, OrderAsc: 6},
 					{Name: "text_value", Type: "text", IsNullable: false, OrderAsc: 7},
 				},
+				PrimaryKey: []string{"id"},
+				Indexes: []Index{
+					{Name: "character_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX character_types_pkey ON public.character_types USING btree (id)"},
+				},
 			},
 			{
 				Name:    "numeric_types",
+				Kind:    TableKindTable,
 				Comment: "numeric types",
 				Columns: []Column{
 					{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
@@ -47,9 +54,14 @@ func NewSchemaLoaderMock() SchemaLoader {
 					{Name: "serial_value", Type: "integer", IsNullable: false, OrderAsc: 17},
 					{Name: "bigserial_value", Type: "bigint", IsNullable: false, OrderAsc: 18},
 				},
+				PrimaryKey: []string{"id"},
+				Indexes: []Index{
+					{Name: "numeric_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX numeric_types_pkey ON public.numeric_types USING btree (id)"},
+				},
 			},
 			{
 				Name: "datetime_types",
+				Kind: TableKindTable,
 				Columns: []Column{
 					{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
 					{Name: "date_value_nullable", Type: "date", IsNullable: true, OrderAsc: 2},
@@ -63,32 +75,121 @@ func NewSchemaLoaderMock() SchemaLoader {
 					{Name: "timestamptz_value", Type: "timestamp with time zone", IsNullable: false, OrderAsc: 10},
 					{Name: "interval_value", Type: "interval", IsNullable: false, OrderAsc: 11},
 				},
+				PrimaryKey: []string{"id"},
+				Indexes: []Index{
+					{Name: "datetime_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX datetime_types_pkey ON public.datetime_types USING btree (id)"},
+				},
 			},
 			{
 				Name: "uuid_types",
+				Kind: TableKindTable,
 				Columns: []Column{
 					{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
 					{Name: "uuid_value_nullable", Type: "uuid", IsNullable: true, OrderAsc: 2},
 					{Name: "uuid_value", Type: "uuid", IsNullable: false, OrderAsc: 3},
 				},
+				PrimaryKey: []string{"id"},
+				Indexes: []Index{
+					{Name: "uuid_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX uuid_types_pkey ON public.uuid_types USING btree (id)"},
+				},
 			},
 			{
 				Name: "money_types",
+				Kind: TableKindTable,
 				Columns: []Column{
 					{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
 					{Name: "money_value_nullable", Type: "money", IsNullable: true, OrderAsc: 2},
 					{Name: "money_value", Type: "money", IsNullable: false, OrderAsc: 3},
 				},
+				PrimaryKey: []string{"id"},
+				Indexes: []Index{
+					{Name: "money_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX money_types_pkey ON public.money_types USING btree (id)"},
+				},
 			},
 			{
 				Name: "boolean_types",
+				Kind: TableKindTable,
 				Columns: []Column{
 					{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
 					{Name: "boolean_value_nullable", Type: "boolean", IsNullable: true, OrderAsc: 2},
 					{Name: "boolean_value", Type: "boolean", IsNullable: false, OrderAsc: 3},
 				},
+				PrimaryKey: []string{"id"},
+				Indexes: []Index{
+					{Name: "boolean_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX boolean_types_pkey ON public.boolean_types USING btree (id)"},
+				},
+			},
+			{
+				Name: "composite_key_types",
+				Kind: TableKindTable,
+				Columns: []Column{
+					{Name: "tenant_id", Type: "integer", IsNullable: false, OrderAsc: 1},
+					{Name: "code", Type: "character varying", IsNullable: false, OrderAsc: 2},
+					{Name: "unique_value", Type: "text", IsNullable: false, OrderAsc: 3},
+					{Name: "indexed_value", Type: "text", IsNullable: true, OrderAsc: 4},
+				},
+				PrimaryKey: []string{"tenant_id", "code"},
+				UniqueConstraints: []UniqueConstraint{
+					{Name: "composite_key_types_unique_value_key", Columns: []string{"unique_value"}},
+				},
+				Indexes: []Index{
+					{Name: "composite_key_types_indexed_value_idx", Columns: []string{"indexed_value"}, Definition: "CREATE INDEX composite_key_types_indexed_value_idx ON public.composite_key_types USING btree (indexed_value)"},
+					{Name: "composite_key_types_lower_indexed_value_idx", IsUnique: true, HasExpression: true, Definition: "CREATE UNIQUE INDEX composite_key_types_lower_indexed_value_idx ON public.composite_key_types USING btree (lower(indexed_value))"},
+					{Name: "composite_key_types_pkey", Columns: []string{"tenant_id", "code"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX composite_key_types_pkey ON public.composite_key_types USING btree (tenant_id, code)"},
+					{Name: "composite_key_types_unique_value_key", Columns: []string{"unique_value"}, IsUnique: true, Definition: "CREATE UNIQUE INDEX composite_key_types_unique_value_key ON public.composite_key_types USING btree (unique_value)"},
+				},
+			},
+			{
+				Name: "enum_types",
+				Kind: TableKindTable,
+				Columns: []Column{
+					{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+					{Name: "mood_value_nullable", Type: "USER-DEFINED", IsNullable: true, OrderAsc: 2, Enum: "mood"},
+					{Name: "mood_value", Type: "USER-DEFINED", IsNullable: false, OrderAsc: 3, Enum: "mood"},
+				},
+				PrimaryKey: []string{"id"},
+				Indexes: []Index{
+					{Name: "enum_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX enum_types_pkey ON public.enum_types USING btree (id)"},
+				},
+			},
+			{
+				Name: "array_types",
+				Kind: TableKindTable,
+				Columns: []Column{
+					{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+					{Name: "text_array_value_nullable", Type: "ARRAY", IsNullable: true, OrderAsc: 2, ArrayDims: 1, ElemType: "text"},
+					{Name: "integer_array_value", Type: "ARRAY", IsNullable: false, OrderAsc: 3, ArrayDims: 1, ElemType: "integer"},
+					{Name: "uuid_array_value", Type: "ARRAY", IsNullable: false, OrderAsc: 4, ArrayDims: 1, ElemType: "uuid"},
+					{Name: "timestamp_array_value", Type: "ARRAY", IsNullable: false, OrderAsc: 5, ArrayDims: 1, ElemType: "timestamp without time zone"},
+					{Name: "integer_matrix_value", Type: "ARRAY", IsNullable: false, OrderAsc: 6, ArrayDims: 2, ElemType: "integer"},
+					{Name: "mood_array_value", Type: "ARRAY", IsNullable: false, OrderAsc: 7, Enum: "mood", ArrayDims: 1, ElemType: "USER-DEFINED"},
+				},
+				PrimaryKey: []string{"id"},
+				Indexes: []Index{
+					{Name: "array_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX array_types_pkey ON public.array_types USING btree (id)"},
+				},
+			},
+			{
+				Name:    "active_authors",
+				Kind:    TableKindView,
+				Comment: "authors with a name",
+				Columns: []Column{
+					{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+					{Name: "name", Type: "text", IsNullable: false, OrderAsc: 2},
+				},
+			},
+			{
+				Name: "book_counts",
+				Kind: TableKindMaterializedView,
+				Columns: []Column{
+					{Name: "author_id", Type: "integer", IsNullable: true, OrderAsc: 1},
+					{Name: "book_count", Type: "bigint", IsNullable: true, OrderAsc: 2},
+				},
 			},
 		},
+		returnEnums: []Enum{
+			{Name: "mood", Comment: "mood of a person", Values: []string{"sad", "ok", "happy"}},
+		},
 		returnError: nil,
 	}
 }
@@ -97,12 +198,22 @@ func (m SchemaLoaderMock) LoadTableSchemas(ctx context.Context) ([]Table, error)
 	return m.returnTables, m.returnError
 }
 
+func (m SchemaLoaderMock) LoadEnums(ctx context.Context) ([]Enum, error) {
+	return m.returnEnums, m.returnError
+}
+
 func (m SchemaLoaderMock) WithTable(returnTables []Table) SchemaLoaderMock {
 	m.returnTables = returnTables
 
 	return m
 }
 
+func (m SchemaLoaderMock) WithEnums(returnEnums []Enum) SchemaLoaderMock {
+	m.returnEnums = returnEnums
+
+	return m
+}
+
 func (m SchemaLoaderMock) WithError(returnError error) SchemaLoaderMock {
 	m.returnError = returnError
 
@@ -0,0 +1,44 @@
+package generator
+
+import "testing"
+
+func TestTableIsUnique(t *testing.T) {
+	table := Table{
+		Name:       "user_roles",
+		PrimaryKey: []string{"user_id", "role_id"},
+		UniqueConstraints: []UniqueConstraint{
+			{Name: "user_roles_code_key", Columns: []string{"code"}},
+		},
+		Indexes: []Index{
+			{Name: "user_roles_email_key", Columns: []string{"email"}, IsUnique: true},
+			{Name: "user_roles_name_idx", Columns: []string{"name"}},
+			{Name: "user_roles_active_idx", Columns: []string{"active_email"}, IsUnique: true, IsPartial: true},
+			{Name: "user_roles_lower_idx", Columns: []string{"login"}, IsUnique: true, HasExpression: true},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		columns []string
+		want    bool
+	}{
+		{"primary key", []string{"user_id", "role_id"}, true},
+		{"primary key in different order", []string{"role_id", "user_id"}, true},
+		{"part of primary key", []string{"user_id"}, false},
+		{"unique constraint", []string{"code"}, true},
+		{"unique index", []string{"email"}, true},
+		{"non-unique index", []string{"name"}, false},
+		{"partial unique index", []string{"active_email"}, false},
+		{"expression unique index", []string{"login"}, false},
+		{"no columns", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := table.IsUnique(tt.columns...); got != tt.want {
+				t.Errorf("Table.IsUnique(%v) = %v, want %v", tt.columns, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package generator
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kmtym1998/chair/generator/config"
+	"github.com/stoewer/go-strcase"
+)
+
+const (
+	tagKeyGorm     = "gorm"
+	tagKeyBun      = "bun"
+	tagKeySqlx     = "sqlx"
+	tagKeyDB       = "db"
+	tagKeyValidate = "validate"
+)
+
+// columnTags returns the struct tags of the column field keyed by tag key.
+// gorm and bun tags tell the columns filled by the database, and validate tags limit the length of the string fields of
+// the columns having a maximum length. Tags of the override of the column replace the generated ones.
+func (g *Generator) columnTags(table Table, column Column) map[string]string {
+	override, _ := g.columnOverride(table, column.Name)
+	if len(g.config.Tags) == 0 && len(override.Tags) == 0 {
+		return nil
+	}
+
+	isPrimaryKey := slices.Contains(table.PrimaryKey, column.Name)
+
+	tags := make(map[string]string, len(g.config.Tags))
+	for _, tag := range g.config.Tags {
+		switch tag.Key {
+		case tagKeyGorm:
+			value := "column:" + column.Name
+			if isPrimaryKey {
+				value += ";primaryKey"
+			}
+			switch {
+			case column.IsAutoIncrement():
+				value += ";autoIncrement"
+			case column.Default != "":
+				// semicolons separate the settings of gorm, so they are escaped
+				value += ";default:" + strings.ReplaceAll(column.Default, ";", `\;`)
+			}
+			if column.IsGenerated {
+				value += ";->"
+			}
+			tags[tag.Key] = value
+		case tagKeyBun:
+			value := column.Name
+			if isPrimaryKey {
+				value += ",pk"
+			}
+			if column.IsAutoIncrement() {
+				value += ",autoincrement"
+			}
+			if column.IsNullable {
+				value += ",nullzero"
+			}
+			tags[tag.Key] = value
+		case tagKeyValidate:
+			// max limits the value instead of the length of fields of other types, e.g. rune for char(1)
+			if column.Length == 0 || !g.isStringField(table, column, override) {
+				continue
+			}
+			value := "max=" + strconv.Itoa(column.Length)
+			if column.IsNullable {
+				// NULL passes the validation
+				value = "omitempty," + value
+			}
+			tags[tag.Key] = value
+		default:
+			tags[tagKey(tag)] = tagValue(tag, styledName(column.Name, tag.Style))
+		}
+	}
+
+	for key, value := range override.Tags {
+		tags[key] = value
+	}
+
+	return tags
+}
+
+// isStringField reports whether the Go type of the column is string, or string when the column were NOT NULL.
+func (g *Generator) isStringField(table Table, column Column, override config.Override) bool {
+	if override.GoType != "" {
+		return override.GoPkg == "" && strings.TrimPrefix(override.GoType, "*") == "string"
+	}
+
+	goPkg, goType, ok := g.nonNullType(table, column)
+	return ok && goPkg == "" && goType == "string"
+}
+
+// relationTags returns the struct tags of the relation field keyed by tag key.
+// Relations are excluded from db tags since they are not columns, and are not validated.
+func (g *Generator) relationTags(table Table, relation Relation) map[string]string {
+	if len(g.config.Tags) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]string, len(g.config.Tags))
+	for _, tag := range g.config.Tags {
+		switch tag.Key {
+		case tagKeyGorm:
+			// foreignKey names the fields of the model holding the foreign key in both directions
+			foreignKey := g.fieldNames(table, relation.Columns)
+			references := g.fieldNames(relation.refTable(), relation.RefColumns)
+			if relation.IsInverse {
+				foreignKey, references = references, foreignKey
+			}
+			tags[tag.Key] = "foreignKey:" + foreignKey + ";references:" + references
+		case tagKeyBun:
+			joins := make([]string, len(relation.Columns))
+			for i, column := range relation.Columns {
+				joins[i] = "join:" + column + "=" + relation.RefColumns[i]
+			}
+			tags[tag.Key] = "rel:" + bunRelationType(relation) + "," + strings.Join(joins, ",")
+		case tagKeyDB, tagKeySqlx:
+			tags[tagKeyDB] = "-"
+		case tagKeyValidate:
+			continue
+		default:
+			tags[tag.Key] = tagValue(tag, styledName(strcase.SnakeCase(relation.Name), tag.Style))
+		}
+	}
+
+	return tags
+}
+
+// tagKey returns the key written in the tag. sqlx reads db tags.
+func tagKey(tag config.TagConfig) string {
+	if tag.Key == tagKeySqlx {
+		return tagKeyDB
+	}
+
+	return tag.Key
+}
+
+func tagValue(tag config.TagConfig, name string) string {
+	if tag.OmitEmpty {
+		return name + ",omitempty"
+	}
+
+	return name
+}
+
+// styledName converts the snake_case name to the naming style.
+func styledName(name, style string) string {
+	switch style {
+	case config.TagStyleSnake:
+		return strcase.SnakeCase(name)
+	case config.TagStyleCamel:
+		return strcase.LowerCamelCase(name)
+	case config.TagStylePascal:
+		return strcase.UpperCamelCase(name)
+	default:
+		return name
+	}
+}
+
+// fieldNames returns the field names of the columns of the table joined by commas as gorm expects.
+func (g *Generator) fieldNames(table Table, columns []string) string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = g.fieldName(table, column)
+	}
+
+	return strings.Join(names, ",")
+}
+
+func bunRelationType(relation Relation) string {
+	switch {
+	case relation.Type == RelationTypeOneToMany:
+		return "has-many"
+	case relation.IsInverse:
+		return "has-one"
+	default:
+		return "belongs-to"
+	}
+}
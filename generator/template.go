@@ -0,0 +1,231 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/gertd/go-pluralize"
+	"github.com/kmtym1998/chair/generator/config"
+	"github.com/stoewer/go-strcase"
+)
+
+// TemplateData is the data passed to the templates.
+type TemplateData struct {
+	PkgName string
+	// Imports are the import specs required by the Go types of the fields, e.g. `"database/sql"` or `null "github.com/guregu/null"`.
+	Imports []string
+	Tables  []TemplateTable
+	Enums   []TemplateEnum
+}
+
+// TemplateTable is a table with the names and types resolved in the same way as the built-in model.
+type TemplateTable struct {
+	Table
+	ModelName string
+	Fields    []TemplateField
+}
+
+// TemplateField is a column with its Go field name, type and struct tag.
+type TemplateField struct {
+	Column
+	Name string
+	// GoType is the Go type as written in code, e.g. "sql.NullString" or "[]uuid.UUID".
+	// It is "interface{}" when no mapping is found.
+	GoType   string
+	IsMapped bool
+	// Tag is the struct tag without backquotes, e.g. `db:"id"`. It is empty when no tags are configured.
+	Tag string
+}
+
+// TemplateEnum is an enum with the names of the generated type and constants.
+type TemplateEnum struct {
+	Enum
+	TypeName   string
+	ConstNames []string
+}
+
+// templateFuncs are the naming helpers available in the templates.
+var templateFuncs = template.FuncMap{
+	"ToUpperCamel": func(s string) string { return Field(s).ToUpperCamel().String() },
+	"ToLowerCamel": strcase.LowerCamelCase,
+	"ToSnake":      strcase.SnakeCase,
+	"ToSingular":   func(s string) string { return Field(s).ToSingular().String() },
+	"ToPlural":     func(s string) string { return pluralize.NewClient().Plural(s) },
+	"ModelName":    modelName,
+	"Join":         func(elems []string, sep string) string { return strings.Join(elems, sep) },
+}
+
+// renderTemplates renders the configured templates.
+func (g *Generator) renderTemplates(tables []Table, enums []Enum) ([]output, error) {
+	data, err := g.templateData(tables, enums)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]output, len(g.config.Templates))
+	for i, tmplConfig := range g.config.Templates {
+		content, err := renderTemplate(tmplConfig, data)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs[i] = output{path: tmplConfig.Output, content: content}
+	}
+
+	return outputs, nil
+}
+
+func renderTemplate(tmplConfig config.TemplateConfig, data TemplateData) ([]byte, error) {
+	tmpl, err := template.New(filepath.Base(tmplConfig.Path)).
+		Funcs(templateFuncs).
+		Option("missingkey=error").
+		ParseFiles(tmplConfig.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template %s: %w", tmplConfig.Path, err)
+	}
+
+	if filepath.Ext(tmplConfig.Output) != ".go" {
+		return buf.Bytes(), nil
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format the output of template %s: %w", tmplConfig.Path, err)
+	}
+
+	return formatted, nil
+}
+
+func (g *Generator) templateData(tables []Table, enums []Enum) (TemplateData, error) {
+	data := TemplateData{
+		PkgName: g.config.PkgName,
+		Tables:  make([]TemplateTable, len(tables)),
+		Enums:   make([]TemplateEnum, len(enums)),
+	}
+
+	var typeStmts []*jen.Statement
+	for i, table := range tables {
+		slices.SortStableFunc(table.Columns, func(a, b Column) int {
+			return a.OrderAsc - b.OrderAsc
+		})
+
+		fields := make([]TemplateField, len(table.Columns))
+		for j, column := range table.Columns {
+			typeStmt, ok := g.fieldType(table, column)
+			typeStmts = append(typeStmts, typeStmt)
+
+			fields[j] = TemplateField{
+				Column:   column,
+				Name:     g.fieldName(table, column.Name),
+				IsMapped: ok,
+				Tag:      structTag(g.columnTags(table, column)),
+			}
+		}
+
+		data.Tables[i] = TemplateTable{
+			Table:     table,
+			ModelName: g.structName(table),
+			Fields:    fields,
+		}
+	}
+
+	imports, goTypes, err := renderTypes(g.config.PkgName, typeStmts)
+	if err != nil {
+		return TemplateData{}, err
+	}
+	data.Imports = imports
+
+	for i := range data.Tables {
+		for j := range data.Tables[i].Fields {
+			data.Tables[i].Fields[j].GoType = goTypes[0]
+			goTypes = goTypes[1:]
+		}
+	}
+
+	for i, enum := range enums {
+		typeName := g.enumTypeNames[enumKey(enum.Schema, enum.Name)]
+		data.Enums[i] = TemplateEnum{
+			Enum:       enum,
+			TypeName:   typeName,
+			ConstNames: enumConstNames(typeName, enum),
+		}
+	}
+
+	return data, nil
+}
+
+// renderTypes renders the types in a single file so that the package aliases are consistent,
+// and returns the import specs of the file and the types as written in code.
+func renderTypes(pkgName string, typeStmts []*jen.Statement) ([]string, []string, error) {
+	file := jen.NewFile(pkgName)
+	for _, typeStmt := range typeStmts {
+		file.Var().Id("_").Add(typeStmt)
+	}
+
+	var buf bytes.Buffer
+	if err := file.Render(&buf); err != nil {
+		return nil, nil, fmt.Errorf("failed to render field types: %w", err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", buf.Bytes(), 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse field types: %w", err)
+	}
+
+	imports := make([]string, len(f.Imports))
+	for i, spec := range f.Imports {
+		imports[i] = spec.Path.Value
+		if spec.Name != nil {
+			imports[i] = spec.Name.Name + " " + spec.Path.Value
+		}
+	}
+
+	var goTypes []string
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			var b bytes.Buffer
+			if err := format.Node(&b, fset, spec.(*ast.ValueSpec).Type); err != nil {
+				return nil, nil, fmt.Errorf("failed to format field type: %w", err)
+			}
+			goTypes = append(goTypes, b.String())
+		}
+	}
+
+	return imports, goTypes, nil
+}
+
+// structTag formats the tags in the same order as jennifer does.
+func structTag(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = fmt.Sprintf("%s:%q", key, tags[key])
+	}
+
+	return strings.Join(pairs, " ")
+}
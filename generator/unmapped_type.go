@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/kmtym1998/chair/generator/config"
+	"github.com/samber/lo"
+)
+
+// unmappedColumn is a column whose type has no mapping.
+type unmappedColumn struct {
+	// Schema is empty if the database has no schemas.
+	Schema string
+	Table  string
+	Column string
+	DBType string
+}
+
+// name returns the name of the column qualified with the table and the schema, e.g. "public.users.location".
+func (c unmappedColumn) name() string {
+	return tableKeys(Table{Schema: c.Schema, Name: c.Table}, c.Column)[0]
+}
+
+func (c unmappedColumn) String() string {
+	return fmt.Sprintf("%s (%s)", c.name(), c.DBType)
+}
+
+// unmappedColumns returns the columns of the tables whose type has no mapping, in the order of the tables and columns.
+func (g *Generator) unmappedColumns(tables []Table) []unmappedColumn {
+	var unmapped []unmappedColumn
+	for _, table := range tables {
+		for _, column := range table.Columns {
+			if _, ok := g.fieldType(table, column); ok {
+				continue
+			}
+
+			unmapped = append(unmapped, unmappedColumn{
+				Schema: table.Schema,
+				Table:  table.Name,
+				Column: column.Name,
+				DBType: columnDBType(column),
+			})
+		}
+	}
+
+	return unmapped
+}
+
+// checkUnmappedTypes reports the columns whose type has no mapping according to the strictness of the config.
+func (g *Generator) checkUnmappedTypes(tables []Table) error {
+	if g.config.UnmappedTypes == config.UnmappedTypesAllow {
+		return nil
+	}
+
+	unmapped := g.unmappedColumns(tables)
+	if len(unmapped) == 0 {
+		return nil
+	}
+
+	if g.config.UnmappedTypes == config.UnmappedTypesError {
+		lines := make([]string, len(unmapped))
+		for i, c := range unmapped {
+			lines[i] = "\t" + c.String()
+		}
+
+		return fmt.Errorf(
+			"no type mapping found for %d column(s), add mappings or set unmappedTypes to warn or allow:\n%s",
+			len(unmapped),
+			strings.Join(lines, "\n"),
+		)
+	}
+
+	fallback := g.fallbackTypeName()
+	for _, c := range unmapped {
+		slog.Warn("no type mapping found", "column", c.name(), "dbType", c.DBType, "goType", fallback)
+	}
+
+	return nil
+}
+
+// fallbackType returns the Go type of the columns whose type has no mapping.
+func (g *Generator) fallbackType() *jen.Statement {
+	if g.config.FallbackType.GoType == "" {
+		return jen.Interface()
+	}
+
+	return qualType(g.config.FallbackType.GoPkg, g.config.FallbackType.GoType)
+}
+
+// fallbackTypeName returns the fallback type as written in Go code, e.g. "json.RawMessage".
+func (g *Generator) fallbackTypeName() string {
+	return fmt.Sprintf("%#v", g.fallbackType())
+}
+
+// columnDBType returns the database type of the column as shown to users, e.g. "text[]" for a text array.
+func columnDBType(column Column) string {
+	if column.ArrayDims == 0 {
+		return lo.Ternary(column.Enum != "", column.Enum, column.Type)
+	}
+
+	elemType := lo.Ternary(column.Enum != "", column.Enum, column.ElemType)
+
+	return elemType + strings.Repeat("[]", column.ArrayDims)
+}
@@ -0,0 +1,467 @@
+package mysql
+
+import (
+	"github.com/kmtym1998/chair/generator/config"
+)
+
+// https://dev.mysql.com/doc/refman/8.0/en/data-types.html
+// date and time types are mapped to time.Time, which requires parseTime=true in the DSN.
+// ENUM columns are mapped to the generated enum types.
+func DefaultMappers() []config.TypeMapping {
+	// https://dev.mysql.com/doc/refman/8.0/en/numeric-types.html
+	numericTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "tinyint",
+			GoType:     "int8",
+			IsNullable: false,
+		},
+		{
+			DBType:     "smallint",
+			GoType:     "int16",
+			IsNullable: false,
+		},
+		{
+			DBType:     "mediumint",
+			GoType:     "int32",
+			IsNullable: false,
+		},
+		{
+			DBType:     "int",
+			GoType:     "int32",
+			IsNullable: false,
+		},
+		{
+			DBType:     "integer",
+			GoType:     "int32",
+			IsNullable: false,
+		},
+		{
+			DBType:     "bigint",
+			GoType:     "int64",
+			IsNullable: false,
+		},
+		{
+			DBType:     "tinyint unsigned",
+			GoType:     "uint8",
+			IsNullable: false,
+		},
+		{
+			DBType:     "smallint unsigned",
+			GoType:     "uint16",
+			IsNullable: false,
+		},
+		{
+			DBType:     "mediumint unsigned",
+			GoType:     "uint32",
+			IsNullable: false,
+		},
+		{
+			DBType:     "int unsigned",
+			GoType:     "uint32",
+			IsNullable: false,
+		},
+		{
+			DBType:     "integer unsigned",
+			GoType:     "uint32",
+			IsNullable: false,
+		},
+		{
+			DBType:     "bigint unsigned",
+			GoType:     "uint64",
+			IsNullable: false,
+		},
+		{
+			DBType:     "decimal",
+			GoType:     "float64",
+			IsNullable: false,
+		},
+		{
+			DBType:     "numeric",
+			GoType:     "float64",
+			IsNullable: false,
+		},
+		{
+			DBType:     "float",
+			GoType:     "float32",
+			IsNullable: false,
+		},
+		{
+			DBType:     "double",
+			GoType:     "float64",
+			IsNullable: false,
+		},
+		{
+			DBType:     "real",
+			GoType:     "float64",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "tinyint",
+			GoType:     "NullInt16",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "smallint",
+			GoType:     "NullInt16",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "mediumint",
+			GoType:     "NullInt32",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "int",
+			GoType:     "NullInt32",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "integer",
+			GoType:     "NullInt32",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "bigint",
+			GoType:     "NullInt64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "tinyint unsigned",
+			GoType:     "NullInt16",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "smallint unsigned",
+			GoType:     "NullInt32",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "mediumint unsigned",
+			GoType:     "NullInt32",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "int unsigned",
+			GoType:     "NullInt64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "integer unsigned",
+			GoType:     "NullInt64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "bigint unsigned",
+			GoType:     "Null[uint64]",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "decimal",
+			GoType:     "NullFloat64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "numeric",
+			GoType:     "NullFloat64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "float",
+			GoType:     "NullFloat64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "double",
+			GoType:     "NullFloat64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "real",
+			GoType:     "NullFloat64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://dev.mysql.com/doc/refman/8.0/en/numeric-type-syntax.html
+	booleanTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "tinyint(1)",
+			GoType:     "bool",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "tinyint(1)",
+			GoType:     "NullBool",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://dev.mysql.com/doc/refman/8.0/en/string-types.html
+	characterTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "char",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "varchar",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "tinytext",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "text",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "mediumtext",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "longtext",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "set",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "binary",
+			GoType:     "[]byte",
+			IsNullable: false,
+		},
+		{
+			DBType:     "varbinary",
+			GoType:     "[]byte",
+			IsNullable: false,
+		},
+		{
+			DBType:     "tinyblob",
+			GoType:     "[]byte",
+			IsNullable: false,
+		},
+		{
+			DBType:     "blob",
+			GoType:     "[]byte",
+			IsNullable: false,
+		},
+		{
+			DBType:     "mediumblob",
+			GoType:     "[]byte",
+			IsNullable: false,
+		},
+		{
+			DBType:     "longblob",
+			GoType:     "[]byte",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "char",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "varchar",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "tinytext",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "text",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "mediumtext",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "longtext",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "set",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "binary",
+			GoType:     "[]byte",
+			IsNullable: true,
+		},
+		{
+			DBType:     "varbinary",
+			GoType:     "[]byte",
+			IsNullable: true,
+		},
+		{
+			DBType:     "tinyblob",
+			GoType:     "[]byte",
+			IsNullable: true,
+		},
+		{
+			DBType:     "blob",
+			GoType:     "[]byte",
+			IsNullable: true,
+		},
+		{
+			DBType:     "mediumblob",
+			GoType:     "[]byte",
+			IsNullable: true,
+		},
+		{
+			DBType:     "longblob",
+			GoType:     "[]byte",
+			IsNullable: true,
+		},
+	}
+
+	// https://dev.mysql.com/doc/refman/8.0/en/date-and-time-types.html
+	datetimeTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "date",
+			GoType:     "Time",
+			GoPkg:      "time",
+			IsNullable: false,
+		},
+		{
+			DBType:     "datetime",
+			GoType:     "Time",
+			GoPkg:      "time",
+			IsNullable: false,
+		},
+		{
+			DBType:     "timestamp",
+			GoType:     "Time",
+			GoPkg:      "time",
+			IsNullable: false,
+		},
+		{
+			DBType:     "time",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "year",
+			GoType:     "int16",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "date",
+			GoType:     "NullTime",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "datetime",
+			GoType:     "NullTime",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "timestamp",
+			GoType:     "NullTime",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "time",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "year",
+			GoType:     "NullInt16",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://dev.mysql.com/doc/refman/8.0/en/json.html
+	jsonTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "json",
+			GoType:     "RawMessage",
+			GoPkg:      "encoding/json",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "json",
+			GoType:     "RawMessage",
+			GoPkg:      "encoding/json",
+			IsNullable: true,
+		},
+	}
+
+	merge := func(arrList ...[]config.TypeMapping) []config.TypeMapping {
+		var itemsCount int
+		for _, arr := range arrList {
+			itemsCount += len(arr)
+		}
+
+		merged := make([]config.TypeMapping, 0, itemsCount)
+		for _, arr := range arrList {
+			merged = append(merged, arr...)
+		}
+
+		return merged
+	}
+
+	return merge(
+		numericTypes,
+		booleanTypes,
+		characterTypes,
+		datetimeTypes,
+		jsonTypes,
+	)
+}
@@ -0,0 +1,568 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/kmtym1998/chair/generator"
+)
+
+type SchemaLoader struct {
+	DB     *sql.DB
+	schema string
+}
+
+// NewSchemaLoader returns a loader for the schema (database).
+// The current database of the connection is used when schema is empty.
+func NewSchemaLoader(db *sql.DB, schema string) *SchemaLoader {
+	return &SchemaLoader{
+		DB:     db,
+		schema: schema,
+	}
+}
+
+func (s *SchemaLoader) LoadTableSchemas(ctx context.Context) ([]generator.Table, error) {
+	schema, err := s.currentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := s.listTables(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.listColumns(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := s.listIndexes(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	foreignKeys, err := s.listForeignKeys(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	tableSchemas := make([]generator.Table, len(tables))
+	for i, table := range tables {
+		columnSchemas := make([]generator.Column, 0, len(columns))
+		for _, column := range columns {
+			if table.TableName != column.TableName {
+				continue
+			}
+
+			columnSchema := generator.Column{
+				Name:       column.ColumnName,
+				Comment:    column.Comment,
+				Type:       columnType(column),
+				IsNullable: strings.ToUpper(column.IsNullable) != "NO",
+				OrderAsc:   column.Position,
+				Default:    columnDefault(column),
+			}
+			if column.DataType == dataTypeEnum {
+				columnSchema.Enum = enumName(column)
+			}
+			// the maximum length of enum and set columns is the one of their values, which is not a limit to validate
+			if column.DataType != dataTypeEnum && column.DataType != dataTypeSet {
+				columnSchema.Length = int(column.CharMaxLength.Int64)
+			}
+			// the precision and the scale are implied by the type except for decimal
+			if column.DataType == dataTypeDecimal {
+				columnSchema.Precision = int(column.NumericPrecision.Int64)
+				columnSchema.Scale = int(column.NumericScale.Int64)
+			}
+			columnSchema.DatetimePrecision = int(column.DatetimePrecision.Int64)
+			extra := strings.ToLower(column.Extra)
+			if strings.Contains(extra, "auto_increment") {
+				// AUTO_INCREMENT columns accept explicit values like identity columns generated by default.
+				columnSchema.IdentityGeneration = generator.IdentityGenerationByDefault
+			}
+			if strings.Contains(extra, "virtual generated") || strings.Contains(extra, "stored generated") {
+				columnSchema.IsGenerated = true
+			}
+
+			columnSchemas = append(columnSchemas, columnSchema)
+		}
+
+		var (
+			primaryKey        []string
+			uniqueConstraints []generator.UniqueConstraint
+			indexSchemas      []generator.Index
+		)
+		for _, index := range indexes {
+			if table.TableName != index.TableName {
+				continue
+			}
+
+			if len(indexSchemas) == 0 || indexSchemas[len(indexSchemas)-1].Name != index.IndexName {
+				indexSchemas = append(indexSchemas, generator.Index{
+					Name:      index.IndexName,
+					IsUnique:  !index.NonUnique,
+					IsPrimary: index.IndexName == primaryIndexName,
+				})
+			}
+
+			last := &indexSchemas[len(indexSchemas)-1]
+			if index.ColumnName.Valid {
+				last.Columns = append(last.Columns, index.ColumnName.String)
+			} else {
+				last.HasExpression = true
+			}
+		}
+
+		for j, index := range indexSchemas {
+			indexSchemas[j].Definition = indexDefinition(index)
+
+			switch {
+			case index.IsPrimary:
+				primaryKey = index.Columns
+			case index.IsUnique && !index.HasExpression:
+				// unique constraints are unique indexes in MySQL
+				uniqueConstraints = append(uniqueConstraints, generator.UniqueConstraint{
+					Name:    index.Name,
+					Columns: index.Columns,
+				})
+			}
+		}
+
+		var foreignKeySchemas []generator.ForeignKey
+		for _, fk := range foreignKeys {
+			if table.TableName != fk.TableName || fk.RefSchemaName != schema {
+				continue
+			}
+
+			fkIndex := slices.IndexFunc(foreignKeySchemas, func(f generator.ForeignKey) bool {
+				return f.Name == fk.ConstraintName
+			})
+			if fkIndex < 0 {
+				foreignKeySchemas = append(foreignKeySchemas, generator.ForeignKey{
+					Name:     fk.ConstraintName,
+					RefTable: fk.RefTableName,
+					// the rules are reported as the actions are written, e.g. "SET NULL"
+					OnDelete: generator.ForeignKeyAction(fk.DeleteRule),
+					OnUpdate: generator.ForeignKeyAction(fk.UpdateRule),
+				})
+				fkIndex = len(foreignKeySchemas) - 1
+			}
+
+			foreignKeySchemas[fkIndex].Columns = append(foreignKeySchemas[fkIndex].Columns, fk.ColumnName)
+			foreignKeySchemas[fkIndex].RefColumns = append(foreignKeySchemas[fkIndex].RefColumns, fk.RefColumnName)
+		}
+
+		kind := generator.TableKindTable
+		comment := table.Comment.String
+		if table.TableType == tableTypeView {
+			kind = generator.TableKindView
+			// MySQL reports "VIEW" as the comment of views
+			comment = ""
+		}
+
+		tableSchemas[i] = generator.Table{
+			Name:              table.TableName,
+			Kind:              kind,
+			Comment:           comment,
+			Columns:           columnSchemas,
+			PrimaryKey:        primaryKey,
+			UniqueConstraints: uniqueConstraints,
+			Indexes:           indexSchemas,
+			ForeignKeys:       foreignKeySchemas,
+		}
+	}
+
+	return tableSchemas, nil
+}
+
+// LoadEnums loads ENUM columns as enums named "<table>_<column>" since MySQL has no named enum types.
+func (s *SchemaLoader) LoadEnums(ctx context.Context) ([]generator.Enum, error) {
+	schema, err := s.currentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.listColumns(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var enums []generator.Enum
+	for _, column := range columns {
+		if column.DataType != dataTypeEnum {
+			continue
+		}
+
+		enums = append(enums, generator.Enum{
+			Name:    enumName(column),
+			Comment: column.Comment,
+			Values:  parseEnumValues(column.ColumnType),
+		})
+	}
+
+	return enums, nil
+}
+
+func (s *SchemaLoader) currentSchema(ctx context.Context) (string, error) {
+	if s.schema != "" {
+		return s.schema, nil
+	}
+
+	var schema sql.NullString
+	if err := s.DB.QueryRowContext(ctx, "SELECT DATABASE();").Scan(&schema); err != nil {
+		return "", fmt.Errorf("failed to get current database: %w", err)
+	}
+
+	if !schema.Valid {
+		return "", fmt.Errorf("no database is selected")
+	}
+
+	return schema.String, nil
+}
+
+const (
+	tableTypeView    = "VIEW"
+	dataTypeEnum     = "enum"
+	dataTypeSet      = "set"
+	dataTypeDecimal  = "decimal"
+	primaryIndexName = "PRIMARY"
+)
+
+// columnType returns the type used for mappings.
+// tinyint(1) is kept as is since it is conventionally a boolean, and unsigned integers are suffixed with " unsigned".
+func columnType(column Column) string {
+	columnType := strings.ToLower(column.ColumnType)
+	if strings.HasPrefix(columnType, "tinyint(1)") {
+		return "tinyint(1)"
+	}
+
+	if strings.Contains(columnType, "unsigned") {
+		return column.DataType + " unsigned"
+	}
+
+	return column.DataType
+}
+
+func enumName(column Column) string {
+	return column.TableName + "_" + column.ColumnName
+}
+
+var enumValueRegex = regexp.MustCompile(`'((?:[^']|'')*)'`)
+
+// parseEnumValues parses the values of a column type like "enum('a','b')".
+// Single quotes in the values are escaped by doubling them.
+func parseEnumValues(columnType string) []string {
+	matches := enumValueRegex.FindAllStringSubmatch(columnType, -1)
+
+	values := make([]string, len(matches))
+	for i, match := range matches {
+		values[i] = strings.ReplaceAll(match[1], "''", "'")
+	}
+
+	return values
+}
+
+// indexDefinition describes the index in the form of SHOW CREATE TABLE.
+func indexDefinition(index generator.Index) string {
+	keys := make([]string, len(index.Columns))
+	for i, column := range index.Columns {
+		keys[i] = "`" + column + "`"
+	}
+	if index.HasExpression {
+		keys = append(keys, "(expression)")
+	}
+
+	switch {
+	case index.IsPrimary:
+		return fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(keys, ","))
+	case index.IsUnique:
+		return fmt.Sprintf("UNIQUE KEY `%s` (%s)", index.Name, strings.Join(keys, ","))
+	default:
+		return fmt.Sprintf("KEY `%s` (%s)", index.Name, strings.Join(keys, ","))
+	}
+}
+
+type Table struct {
+	SchemaName string         `db:"TABLE_SCHEMA"`
+	TableName  string         `db:"TABLE_NAME"`
+	TableType  string         `db:"TABLE_TYPE"`
+	Comment    sql.NullString `db:"TABLE_COMMENT"`
+}
+
+func (s *SchemaLoader) listTables(ctx context.Context, schema string) ([]Table, error) {
+	const query = `
+SELECT
+	TABLE_SCHEMA,
+	TABLE_NAME,
+	TABLE_TYPE,
+	TABLE_COMMENT
+FROM
+	information_schema.TABLES
+WHERE
+	TABLE_SCHEMA = ?
+	AND TABLE_TYPE IN ('BASE TABLE', 'VIEW')
+ORDER BY
+	TABLE_NAME ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "schema", schema)
+
+	rows, err := s.DB.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []Table
+	for rows.Next() {
+		var table Table
+		if err := rows.Scan(
+			&table.SchemaName,
+			&table.TableName,
+			&table.TableType,
+			&table.Comment,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan tables: %w", err)
+		}
+
+		tables = append(tables, table)
+	}
+
+	return tables, rows.Err()
+}
+
+// columnDefault returns the default expression of the column, or empty when it has none.
+// MariaDB reports "NULL" for nullable columns without a default, whereas MySQL reports NULL.
+func columnDefault(column Column) string {
+	if !column.Default.Valid || column.Default.String == "NULL" {
+		return ""
+	}
+
+	return column.Default.String
+}
+
+type Column struct {
+	SchemaName string `db:"TABLE_SCHEMA"`
+	TableName  string `db:"TABLE_NAME"`
+	ColumnName string `db:"COLUMN_NAME"`
+	DataType   string `db:"DATA_TYPE"`
+	ColumnType string `db:"COLUMN_TYPE"`
+	IsNullable string `db:"IS_NULLABLE"`
+	Position   int    `db:"ORDINAL_POSITION"`
+	Comment    string `db:"COLUMN_COMMENT"`
+	// Default is NULL when the column has no default.
+	Default sql.NullString `db:"COLUMN_DEFAULT"`
+	// Extra holds "auto_increment", "VIRTUAL GENERATED" or "STORED GENERATED" among others.
+	Extra string `db:"EXTRA"`
+	// the sizes are NULL when they do not apply to the type
+	CharMaxLength     sql.NullInt64 `db:"CHARACTER_MAXIMUM_LENGTH"`
+	NumericPrecision  sql.NullInt64 `db:"NUMERIC_PRECISION"`
+	NumericScale      sql.NullInt64 `db:"NUMERIC_SCALE"`
+	DatetimePrecision sql.NullInt64 `db:"DATETIME_PRECISION"`
+}
+
+func (s *SchemaLoader) listColumns(ctx context.Context, schema string) ([]Column, error) {
+	const query = `
+SELECT
+	TABLE_SCHEMA,
+	TABLE_NAME,
+	COLUMN_NAME,
+	DATA_TYPE,
+	COLUMN_TYPE,
+	IS_NULLABLE,
+	ORDINAL_POSITION,
+	COLUMN_COMMENT,
+	COLUMN_DEFAULT,
+	EXTRA,
+	CHARACTER_MAXIMUM_LENGTH,
+	NUMERIC_PRECISION,
+	NUMERIC_SCALE,
+	DATETIME_PRECISION
+FROM
+	information_schema.COLUMNS
+WHERE
+	TABLE_SCHEMA = ?
+ORDER BY
+	TABLE_NAME ASC,
+	ORDINAL_POSITION ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "schema", schema)
+
+	rows, err := s.DB.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var columns []Column
+	for rows.Next() {
+		var column Column
+		if err := rows.Scan(
+			&column.SchemaName,
+			&column.TableName,
+			&column.ColumnName,
+			&column.DataType,
+			&column.ColumnType,
+			&column.IsNullable,
+			&column.Position,
+			&column.Comment,
+			&column.Default,
+			&column.Extra,
+			&column.CharMaxLength,
+			&column.NumericPrecision,
+			&column.NumericScale,
+			&column.DatetimePrecision,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan columns: %w", err)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, rows.Err()
+}
+
+type Index struct {
+	SchemaName string         `db:"TABLE_SCHEMA"`
+	TableName  string         `db:"TABLE_NAME"`
+	IndexName  string         `db:"INDEX_NAME"`
+	NonUnique  bool           `db:"NON_UNIQUE"`
+	ColumnName sql.NullString `db:"COLUMN_NAME"`
+}
+
+// listIndexes lists indexes with one row per key part. COLUMN_NAME is NULL for functional key parts.
+func (s *SchemaLoader) listIndexes(ctx context.Context, schema string) ([]Index, error) {
+	const query = `
+SELECT
+	TABLE_SCHEMA,
+	TABLE_NAME,
+	INDEX_NAME,
+	NON_UNIQUE,
+	COLUMN_NAME
+FROM
+	information_schema.STATISTICS
+WHERE
+	TABLE_SCHEMA = ?
+ORDER BY
+	TABLE_NAME ASC,
+	INDEX_NAME = 'PRIMARY' DESC,
+	INDEX_NAME ASC,
+	SEQ_IN_INDEX ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "schema", schema)
+
+	rows, err := s.DB.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var indexes []Index
+	for rows.Next() {
+		var index Index
+		if err := rows.Scan(
+			&index.SchemaName,
+			&index.TableName,
+			&index.IndexName,
+			&index.NonUnique,
+			&index.ColumnName,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan indexes: %w", err)
+		}
+
+		indexes = append(indexes, index)
+	}
+
+	return indexes, rows.Err()
+}
+
+type ForeignKey struct {
+	SchemaName     string `db:"TABLE_SCHEMA"`
+	TableName      string `db:"TABLE_NAME"`
+	ConstraintName string `db:"CONSTRAINT_NAME"`
+	ColumnName     string `db:"COLUMN_NAME"`
+	RefSchemaName  string `db:"REFERENCED_TABLE_SCHEMA"`
+	RefTableName   string `db:"REFERENCED_TABLE_NAME"`
+	RefColumnName  string `db:"REFERENCED_COLUMN_NAME"`
+	DeleteRule     string `db:"DELETE_RULE"`
+	UpdateRule     string `db:"UPDATE_RULE"`
+}
+
+// listForeignKeys lists foreign keys with one row per column, ordered by the column order of each constraint.
+func (s *SchemaLoader) listForeignKeys(ctx context.Context, schema string) ([]ForeignKey, error) {
+	const query = `
+SELECT
+	kcu.TABLE_SCHEMA,
+	kcu.TABLE_NAME,
+	kcu.CONSTRAINT_NAME,
+	kcu.COLUMN_NAME,
+	kcu.REFERENCED_TABLE_SCHEMA,
+	kcu.REFERENCED_TABLE_NAME,
+	kcu.REFERENCED_COLUMN_NAME,
+	rc.DELETE_RULE,
+	rc.UPDATE_RULE
+FROM
+	information_schema.KEY_COLUMN_USAGE AS kcu
+	JOIN information_schema.REFERENTIAL_CONSTRAINTS AS rc
+		ON rc.CONSTRAINT_SCHEMA = kcu.CONSTRAINT_SCHEMA
+		AND rc.TABLE_NAME = kcu.TABLE_NAME
+		AND rc.CONSTRAINT_NAME = kcu.CONSTRAINT_NAME
+WHERE
+	kcu.TABLE_SCHEMA = ?
+	AND kcu.REFERENCED_TABLE_NAME IS NOT NULL
+ORDER BY
+	kcu.TABLE_NAME ASC,
+	kcu.CONSTRAINT_NAME ASC,
+	kcu.ORDINAL_POSITION ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "schema", schema)
+
+	rows, err := s.DB.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var foreignKeys []ForeignKey
+	for rows.Next() {
+		var fk ForeignKey
+		if err := rows.Scan(
+			&fk.SchemaName,
+			&fk.TableName,
+			&fk.ConstraintName,
+			&fk.ColumnName,
+			&fk.RefSchemaName,
+			&fk.RefTableName,
+			&fk.RefColumnName,
+			&fk.DeleteRule,
+			&fk.UpdateRule,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan foreign keys: %w", err)
+		}
+
+		foreignKeys = append(foreignKeys, fk)
+	}
+
+	return foreignKeys, rows.Err()
+}
+
+func normalizeQuery(query string) string {
+	tabAndNewlineRegex := regexp.MustCompile("[\t\n]")
+	replaced := tabAndNewlineRegex.ReplaceAllString(query, " ")
+
+	spaceRegex := regexp.MustCompile(`\s+`)
+	replaced = spaceRegex.ReplaceAllString(replaced, " ")
+
+	replaced = strings.Trim(replaced, " ")
+
+	return replaced
+}
+
+func (s *SchemaLoader) Dialect() generator.Dialect {
+	return generator.DialectMySQL
+}
@@ -0,0 +1,218 @@
+package mysql
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/kmtym1998/chair/generator"
+	"github.com/kmtym1998/chair/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var ddlList = []string{
+	"CREATE TABLE numeric_types (" +
+		"id INT AUTO_INCREMENT PRIMARY KEY," +
+		"tinyint_value TINYINT NOT NULL," +
+		"int_unsigned_value INT UNSIGNED NOT NULL," +
+		"bigint_value_nullable BIGINT," +
+		"decimal_value DECIMAL(10, 2) NOT NULL," +
+		"bool_value TINYINT(1) NOT NULL" +
+		") COMMENT 'numeric types';",
+	"CREATE TABLE users (" +
+		"id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY," +
+		"email VARCHAR(255) NOT NULL COMMENT 'email address'," +
+		"status ENUM('active', 'it''s', 'banned') NOT NULL," +
+		"UNIQUE KEY users_email_key (email)" +
+		");",
+	"CREATE TABLE posts (" +
+		"id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY," +
+		"user_id BIGINT UNSIGNED NOT NULL," +
+		"title TEXT," +
+		"KEY posts_user_id_idx (user_id)," +
+		// the actions are explicit since the default is reported as "NO ACTION" by MySQL and as "RESTRICT" by MariaDB
+		"CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE ON UPDATE RESTRICT" +
+		");",
+	"CREATE VIEW active_users AS SELECT id, email FROM users WHERE status = 'active';",
+}
+
+func TestLoadTableSchemas(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		repository string
+		ver        string
+	}{
+		{"MySQL v8.0", "mysql", "8.0"},
+		{"MySQL v8.4", "mysql", "8.4"},
+		{"MariaDB v10.11", "mariadb", "10.11"},
+		{"MariaDB v11.4", "mariadb", "11.4"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			container, err := testutil.NewMySQLContainer(
+				t,
+				tc.repository,
+				tc.ver,
+				testutil.TestDBOptions{
+					User:     "test",
+					Password: "test",
+					DBName:   "test",
+				},
+			)
+			if err != nil {
+				t.Fatalf("failed to setup mysql container: %v", err)
+			}
+			defer container.Purge()
+
+			db, err := container.ConnectDB()
+			if err != nil {
+				t.Fatalf("failed to connect to mysql container: %v", err)
+			}
+			defer db.Close()
+
+			for _, ddl := range ddlList {
+				_, err := db.Exec(ddl)
+				if err != nil {
+					t.Fatalf("failed to create table: %v", err)
+				}
+			}
+
+			ldr := NewSchemaLoader(db, "")
+			actual, err := ldr.LoadTableSchemas(context.Background())
+			if err != nil {
+				t.Fatalf("failed to load table schemas: %v", err)
+			}
+
+			expected := []generator.Table{
+				{
+					Name: "active_users",
+					Kind: generator.TableKindView,
+					Columns: []generator.Column{
+						{Name: "id", Type: "bigint unsigned", IsNullable: false, OrderAsc: 1},
+						{Name: "email", Type: "varchar", IsNullable: false, OrderAsc: 2, Comment: "email address", Length: 255},
+					},
+				},
+				{
+					Name:    "numeric_types",
+					Kind:    generator.TableKindTable,
+					Comment: "numeric types",
+					Columns: []generator.Column{
+						{Name: "id", Type: "int", IsNullable: false, OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationByDefault},
+						{Name: "tinyint_value", Type: "tinyint", IsNullable: false, OrderAsc: 2},
+						{Name: "int_unsigned_value", Type: "int unsigned", IsNullable: false, OrderAsc: 3},
+						{Name: "bigint_value_nullable", Type: "bigint", IsNullable: true, OrderAsc: 4},
+						{Name: "decimal_value", Type: "decimal", IsNullable: false, OrderAsc: 5, Precision: 10, Scale: 2},
+						{Name: "bool_value", Type: "tinyint(1)", IsNullable: false, OrderAsc: 6},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "PRIMARY", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "PRIMARY KEY (`id`)"},
+					},
+				},
+				{
+					Name: "posts",
+					Kind: generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "bigint unsigned", IsNullable: false, OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationByDefault},
+						{Name: "user_id", Type: "bigint unsigned", IsNullable: false, OrderAsc: 2},
+						{Name: "title", Type: "text", IsNullable: true, OrderAsc: 3, Length: 65535},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "PRIMARY", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "PRIMARY KEY (`id`)"},
+						{Name: "posts_user_id_idx", Columns: []string{"user_id"}, Definition: "KEY `posts_user_id_idx` (`user_id`)"},
+					},
+					ForeignKeys: []generator.ForeignKey{
+						{Name: "posts_user_id_fkey", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}, OnDelete: generator.ForeignKeyActionCascade, OnUpdate: generator.ForeignKeyActionRestrict},
+					},
+				},
+				{
+					Name: "users",
+					Kind: generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "bigint unsigned", IsNullable: false, OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationByDefault},
+						{Name: "email", Type: "varchar", IsNullable: false, OrderAsc: 2, Comment: "email address", Length: 255},
+						{Name: "status", Type: "enum", IsNullable: false, OrderAsc: 3, Enum: "users_status"},
+					},
+					PrimaryKey: []string{"id"},
+					UniqueConstraints: []generator.UniqueConstraint{
+						{Name: "users_email_key", Columns: []string{"email"}},
+					},
+					Indexes: []generator.Index{
+						{Name: "PRIMARY", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "PRIMARY KEY (`id`)"},
+						{Name: "users_email_key", Columns: []string{"email"}, IsUnique: true, Definition: "UNIQUE KEY `users_email_key` (`email`)"},
+					},
+				},
+			}
+
+			t.Run("assert table length", func(t *testing.T) {
+				assert.Len(t, actual, 4)
+			})
+
+			t.Run("assert table schema content", func(t *testing.T) {
+				for _, exp := range expected {
+					i := slices.IndexFunc(actual, func(act generator.Table) bool {
+						return act.Schema == exp.Schema && act.Name == exp.Name
+					})
+					if i < 0 {
+						t.Errorf("table %s.%s is not loaded", exp.Schema, exp.Name)
+						continue
+					}
+
+					assert.Equal(t, exp, actual[i])
+				}
+			})
+
+			t.Run("assert enums", func(t *testing.T) {
+				enums, err := ldr.LoadEnums(context.Background())
+				if err != nil {
+					t.Fatalf("failed to load enums: %v", err)
+				}
+
+				assert.Equal(t, []generator.Enum{
+					{Name: "users_status", Values: []string{"active", "it's", "banned"}},
+				}, enums)
+			})
+		})
+	}
+}
+
+func TestParseEnumValues(t *testing.T) {
+	tests := []struct {
+		columnType string
+		want       []string
+	}{
+		{"enum('a','b','c')", []string{"a", "b", "c"}},
+		{"enum('it''s','comma,value')", []string{"it's", "comma,value"}},
+		{"enum('')", []string{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.columnType, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, parseEnumValues(tt.columnType))
+		})
+	}
+}
+
+func TestColumnType(t *testing.T) {
+	tests := []struct {
+		column Column
+		want   string
+	}{
+		{Column{DataType: "int", ColumnType: "int"}, "int"},
+		{Column{DataType: "int", ColumnType: "int unsigned"}, "int unsigned"},
+		{Column{DataType: "int", ColumnType: "int(10) unsigned"}, "int unsigned"},
+		{Column{DataType: "tinyint", ColumnType: "tinyint(1)"}, "tinyint(1)"},
+		{Column{DataType: "tinyint", ColumnType: "tinyint(4)"}, "tinyint"},
+		{Column{DataType: "varchar", ColumnType: "varchar(255)"}, "varchar"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.column.ColumnType, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, columnType(tt.column))
+		})
+	}
+}
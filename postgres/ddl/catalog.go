@@ -0,0 +1,528 @@
+package ddl
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const defaultSchema = "public"
+
+type qualifiedName struct {
+	schema string
+	name   string
+}
+
+func (n qualifiedName) String() string {
+	return quoteIdent(n.schema) + "." + quoteIdent(n.name)
+}
+
+var plainIdentRegex = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
+// quoteIdent quotes the identifier when it cannot be written without quotes, as quote_ident does.
+func quoteIdent(name string) string {
+	if plainIdentRegex.MatchString(name) {
+		return name
+	}
+
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// catalog is the schema built up by applying DDL statements in order.
+type catalog struct {
+	// searchPath is the schema of unqualified names, which can be changed by SET search_path.
+	searchPath string
+	tables     []*table
+	enums      []*enum
+	domains    []*domain
+	// skipped holds the relations which are not loaded, such as views and partitions,
+	// so that statements on them are ignored instead of failing.
+	skipped map[qualifiedName]bool
+}
+
+func newCatalog() *catalog {
+	return &catalog{
+		searchPath: defaultSchema,
+		skipped:    make(map[qualifiedName]bool),
+	}
+}
+
+// qualify fills the schema of an unqualified name with the search path.
+func (c *catalog) qualify(schema, name string) qualifiedName {
+	if schema == "" {
+		schema = c.searchPath
+	}
+
+	return qualifiedName{schema: schema, name: name}
+}
+
+func (c *catalog) table(name qualifiedName) *table {
+	for _, t := range c.tables {
+		if t.name == name {
+			return t
+		}
+	}
+
+	return nil
+}
+
+func (c *catalog) enum(name qualifiedName) *enum {
+	for _, e := range c.enums {
+		if e.name == name {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (c *catalog) domain(name qualifiedName) *domain {
+	for _, d := range c.domains {
+		if d.name == name {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// typeExists reports whether the enum or the domain exists. Types of other kinds are not loaded.
+func (c *catalog) typeExists(name qualifiedName) bool {
+	return c.enum(name) != nil || c.domain(name) != nil
+}
+
+// baseType resolves the domain to its base type, which information_schema.columns reports for the columns of
+// a domain. The array dimensions of the type are added to those of the base type. notNull reports whether the type is
+// a NOT NULL domain itself; an array of such a domain can be NULL.
+func (c *catalog) baseType(typ typeRef) (base typeRef, notNull bool) {
+	// a domain can be based on another domain, and the loop is bounded in case of a cycle by renames
+	for i := 0; i <= len(c.domains); i++ {
+		if _, ok := typ.builtinDataType(); ok {
+			break
+		}
+
+		d := c.domain(c.qualify(typ.schema, typ.name))
+		if d == nil {
+			break
+		}
+		if i == 0 && typ.arrayDims == 0 {
+			notNull = d.notNull
+		}
+
+		arrayDims := typ.arrayDims
+		typ = d.typ
+		typ.arrayDims += arrayDims
+	}
+
+	return typ, notNull
+}
+
+// renameType renames the enum or the domain in the column types and the base types of domains referencing it.
+func (c *catalog) renameType(oldName, newName qualifiedName) {
+	rename := func(typ *typeRef) {
+		if c.qualify(typ.schema, typ.name) == oldName {
+			typ.schema = newName.schema
+			typ.name = newName.name
+		}
+	}
+
+	for _, t := range c.tables {
+		for _, col := range t.columns {
+			rename(&col.typ)
+		}
+	}
+	for _, d := range c.domains {
+		rename(&d.typ)
+	}
+}
+
+// children returns the tables inheriting directly from the table.
+func (c *catalog) children(t *table) []*table {
+	var children []*table
+	for _, other := range c.tables {
+		if slices.Contains(other.parents, t.name) {
+			children = append(children, other)
+		}
+	}
+
+	return children
+}
+
+// descendants returns the tables inheriting from the table directly or indirectly.
+func (c *catalog) descendants(t *table) []*table {
+	var descendants []*table
+	for _, child := range c.children(t) {
+		if !slices.Contains(descendants, child) {
+			descendants = append(descendants, child)
+		}
+		for _, d := range c.descendants(child) {
+			if !slices.Contains(descendants, d) {
+				descendants = append(descendants, d)
+			}
+		}
+	}
+
+	return descendants
+}
+
+// inherit makes the table a child of the parents as CREATE TABLE ... INHERITS does. The columns of the parents come
+// first in the order of the parents followed by the columns of the table.
+func (c *catalog) inherit(t *table, parents []*table) {
+	local := t.columns
+	t.columns = nil
+	t.lastPosition = 0
+
+	for _, parent := range parents {
+		for _, col := range parent.columns {
+			t.inheritColumn(col)
+		}
+		t.parents = append(t.parents, parent.name)
+	}
+
+	for _, col := range local {
+		existing := t.column(col.name)
+		if existing == nil {
+			t.addColumn(col)
+			continue
+		}
+
+		existing.isNullable = existing.isNullable && col.isNullable
+		if col.defaultExpr != "" {
+			existing.defaultExpr = col.defaultExpr
+		}
+		existing.isLocal = true
+	}
+}
+
+// addInheritedColumn adds the column added to the parent to its descendants as ALTER TABLE ... ADD COLUMN does.
+func (c *catalog) addInheritedColumn(t *table, col *column) {
+	for _, child := range c.descendants(t) {
+		child.inheritColumn(col)
+	}
+}
+
+// dropInheritedColumn drops the column dropped from the parent from its children unless they define it by themselves.
+// With ONLY, the columns of the children are kept as their own columns.
+func (c *catalog) dropInheritedColumn(t *table, name string, only bool) {
+	for _, child := range c.children(t) {
+		col := child.column(name)
+		if col == nil || col.inhCount == 0 {
+			continue
+		}
+
+		col.inhCount--
+		if only {
+			col.isLocal = true
+			continue
+		}
+
+		if col.inhCount == 0 && !col.isLocal {
+			child.dropColumn(name)
+			c.dropInheritedColumn(child, name, false)
+		}
+	}
+}
+
+// index finds the index by name. Index names are unique within a schema.
+func (c *catalog) index(name qualifiedName) (*table, *index) {
+	for _, t := range c.tables {
+		if t.name.schema != name.schema {
+			continue
+		}
+
+		for _, idx := range t.indexes {
+			if idx.name == name.name {
+				return t, idx
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *catalog) dropTable(name qualifiedName) {
+	// the tables inheriting from the table are dropped together as DROP TABLE ... CASCADE does
+	dropped := []qualifiedName{name}
+	if t := c.table(name); t != nil {
+		for _, d := range c.descendants(t) {
+			dropped = append(dropped, d.name)
+		}
+	}
+
+	c.tables = slices.DeleteFunc(c.tables, func(t *table) bool {
+		return slices.Contains(dropped, t.name)
+	})
+
+	// foreign keys referencing the table are dropped together as DROP TABLE ... CASCADE does
+	for _, t := range c.tables {
+		t.foreignKeys = slices.DeleteFunc(t.foreignKeys, func(fk *foreignKey) bool {
+			return slices.Contains(dropped, fk.refTable)
+		})
+	}
+}
+
+func (c *catalog) renameTable(t *table, newName string) {
+	oldName := t.name
+	t.name.name = newName
+	c.replaceTableReferences(oldName, t.name)
+}
+
+// replaceTableReferences replaces the table referenced by foreign keys and inheritances after renaming.
+func (c *catalog) replaceTableReferences(oldName, newName qualifiedName) {
+	for _, other := range c.tables {
+		for _, fk := range other.foreignKeys {
+			if fk.refTable == oldName {
+				fk.refTable = newName
+			}
+		}
+		for i, parent := range other.parents {
+			if parent == oldName {
+				other.parents[i] = newName
+			}
+		}
+	}
+}
+
+func (c *catalog) renameColumn(t *table, oldName, newName string) {
+	t.renameColumn(oldName, newName)
+
+	for _, other := range c.tables {
+		for _, fk := range other.foreignKeys {
+			if fk.refTable == t.name {
+				fk.refColumns = replaceString(fk.refColumns, oldName, newName)
+			}
+		}
+	}
+}
+
+type table struct {
+	name    qualifiedName
+	comment string
+	columns []*column
+	// lastPosition is the position of the last added column.
+	// Positions are not reused after dropping columns as attnum in PostgreSQL.
+	lastPosition int
+	primaryKey   *constraint
+	uniques      []*constraint
+	foreignKeys  []*foreignKey
+	indexes      []*index
+	// parents are the tables the table inherits from.
+	parents []qualifiedName
+}
+
+func (t *table) column(name string) *column {
+	for _, col := range t.columns {
+		if col.name == name {
+			return col
+		}
+	}
+
+	return nil
+}
+
+func (t *table) addColumn(col *column) {
+	t.lastPosition++
+	col.position = t.lastPosition
+	t.columns = append(t.columns, col)
+}
+
+// inheritColumn adds the column of a parent to the table, or merges it into the column of the same name, which is
+// NOT NULL when either of them is. Comments and identities are not inherited.
+func (t *table) inheritColumn(col *column) {
+	if existing := t.column(col.name); existing != nil {
+		existing.isNullable = existing.isNullable && col.isNullable
+		existing.inhCount++
+		return
+	}
+
+	inherited := *col
+	inherited.comment = ""
+	inherited.identity = ""
+	inherited.isLocal = false
+	inherited.inhCount = 1
+	t.addColumn(&inherited)
+}
+
+// dropColumn drops the column together with the constraints and indexes using it.
+func (t *table) dropColumn(name string) {
+	t.columns = slices.DeleteFunc(t.columns, func(col *column) bool {
+		return col.name == name
+	})
+
+	if t.primaryKey != nil && slices.Contains(t.primaryKey.columns, name) {
+		t.primaryKey = nil
+	}
+	t.uniques = slices.DeleteFunc(t.uniques, func(u *constraint) bool {
+		return slices.Contains(u.columns, name)
+	})
+	t.foreignKeys = slices.DeleteFunc(t.foreignKeys, func(fk *foreignKey) bool {
+		return slices.Contains(fk.columns, name)
+	})
+	t.indexes = slices.DeleteFunc(t.indexes, func(idx *index) bool {
+		return idx.usesColumn(name)
+	})
+}
+
+func (t *table) renameColumn(oldName, newName string) {
+	if col := t.column(oldName); col != nil {
+		col.name = newName
+	}
+
+	if t.primaryKey != nil {
+		t.primaryKey.columns = replaceString(t.primaryKey.columns, oldName, newName)
+	}
+	for _, u := range t.uniques {
+		u.columns = replaceString(u.columns, oldName, newName)
+	}
+	for _, fk := range t.foreignKeys {
+		fk.columns = replaceString(fk.columns, oldName, newName)
+	}
+	for _, idx := range t.indexes {
+		idx.renameColumn(oldName, newName)
+	}
+}
+
+// dropConstraint drops the primary key, unique or foreign key constraint by name.
+func (t *table) dropConstraint(name string) {
+	if t.primaryKey != nil && t.primaryKey.name == name {
+		t.primaryKey = nil
+	}
+	t.uniques = slices.DeleteFunc(t.uniques, func(u *constraint) bool {
+		return u.name == name
+	})
+	t.foreignKeys = slices.DeleteFunc(t.foreignKeys, func(fk *foreignKey) bool {
+		return fk.name == name
+	})
+}
+
+func (t *table) renameConstraint(oldName, newName string) {
+	if t.primaryKey != nil && t.primaryKey.name == oldName {
+		t.primaryKey.name = newName
+	}
+	for _, u := range t.uniques {
+		if u.name == oldName {
+			u.name = newName
+		}
+	}
+	for _, fk := range t.foreignKeys {
+		if fk.name == oldName {
+			fk.name = newName
+		}
+	}
+}
+
+func (t *table) dropIndex(name string) {
+	t.indexes = slices.DeleteFunc(t.indexes, func(idx *index) bool {
+		return idx.name == name
+	})
+}
+
+type column struct {
+	name       string
+	typ        typeRef
+	isNullable bool
+	comment    string
+	position   int
+	// defaultExpr is the default expression rendered by renderTokens. Serial columns default to the next value of
+	// their sequence as PostgreSQL defines them.
+	defaultExpr string
+	// identity is "ALWAYS" or "BY DEFAULT" for identity columns.
+	identity    string
+	isGenerated bool
+	// isLocal is true when the column is defined by the table itself rather than inherited from the parents.
+	isLocal bool
+	// inhCount is the number of the parents the column is inherited from.
+	inhCount int
+}
+
+// constraint is a primary key or unique constraint.
+type constraint struct {
+	name    string
+	columns []string
+}
+
+type foreignKey struct {
+	name     string
+	columns  []string
+	refTable qualifiedName
+	// refColumns is empty when the foreign key references the primary key implicitly.
+	refColumns []string
+	// onDelete and onUpdate are the referential actions in upper case, e.g. "SET NULL".
+	onDelete            string
+	onUpdate            string
+	isDeferrable        bool
+	isInitiallyDeferred bool
+}
+
+type index struct {
+	name     string
+	isUnique bool
+	method   string
+	keys     []indexKey
+	// where is the predicate of a partial index.
+	where []token
+}
+
+// indexKey is a key column or an expression of an index, including its options such as DESC.
+type indexKey struct {
+	// column is empty for expressions.
+	column string
+	tokens []token
+}
+
+func (idx *index) usesColumn(name string) bool {
+	for _, key := range idx.keys {
+		for _, t := range key.tokens {
+			if t.isIdent() && t.text == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (idx *index) renameColumn(oldName, newName string) {
+	for i, key := range idx.keys {
+		if key.column == oldName {
+			idx.keys[i].column = newName
+		}
+		for j, t := range key.tokens {
+			if t.isIdent() && t.text == oldName {
+				idx.keys[i].tokens[j] = identToken(newName, t.line)
+			}
+		}
+	}
+}
+
+type enum struct {
+	name    qualifiedName
+	comment string
+	values  []string
+}
+
+// domain is a type based on another type with constraints, whose columns are loaded with the base type.
+type domain struct {
+	name    qualifiedName
+	typ     typeRef
+	notNull bool
+}
+
+// identToken makes an identifier token, which is quoted when the name cannot be written without quotes.
+func identToken(name string, line int) token {
+	if plainIdentRegex.MatchString(name) {
+		return token{kind: tokenIdent, text: name, line: line}
+	}
+
+	return token{kind: tokenQuotedIdent, text: name, line: line}
+}
+
+func replaceString(values []string, oldValue, newValue string) []string {
+	for i, v := range values {
+		if v == oldValue {
+			values[i] = newValue
+		}
+	}
+
+	return values
+}
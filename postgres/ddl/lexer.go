@@ -0,0 +1,393 @@
+package ddl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	// tokenIdent is an unquoted identifier or keyword. The text is folded to lower case as PostgreSQL does.
+	tokenIdent tokenKind = iota
+	// tokenQuotedIdent is a double-quoted identifier. The text is kept as is without the quotes.
+	tokenQuotedIdent
+	// tokenString is a string literal. The text is the unescaped content without the quotes.
+	tokenString
+	tokenNumber
+	// tokenSymbol is a punctuation or an operator, e.g. "(", ",", "::", "<>".
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+// isKeyword reports whether the token is the unquoted keyword. kw must be lower case.
+func (t token) isKeyword(kw string) bool {
+	return t.kind == tokenIdent && t.text == kw
+}
+
+func (t token) isSymbol(s string) bool {
+	return t.kind == tokenSymbol && t.text == s
+}
+
+func (t token) isIdent() bool {
+	return t.kind == tokenIdent || t.kind == tokenQuotedIdent
+}
+
+// String renders the token as it appears in SQL.
+func (t token) String() string {
+	switch t.kind {
+	case tokenQuotedIdent:
+		return `"` + strings.ReplaceAll(t.text, `"`, `""`) + `"`
+	case tokenString:
+		return "'" + strings.ReplaceAll(t.text, "'", "''") + "'"
+	default:
+		return t.text
+	}
+}
+
+const operatorChars = "+-*/<>=~!@#%^&|`?"
+
+// tokenize splits the SQL into tokens, dropping whitespaces and comments.
+// psql meta-commands such as \connect and \restrict, and the data following COPY ... FROM stdin are dropped as well,
+// so that the output of pg_dump can be read.
+func tokenize(src string) ([]token, error) {
+	var (
+		tokens []token
+		line   = 1
+		rs     = []rune(src)
+		// lineStart is true while only whitespaces are read since the beginning of the line.
+		lineStart = true
+		// stmtStart is the index of the first token of the current statement.
+		stmtStart int
+	)
+
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		start := line
+
+		if r != '\n' && !unicode.IsSpace(r) {
+			lineStart = r == '\\' && lineStart
+		}
+
+		switch {
+		case r == '\n':
+			line++
+			i++
+			lineStart = true
+		case unicode.IsSpace(r):
+			i++
+		case lineStart:
+			// a meta-command takes the rest of the line
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+		case r == ';':
+			tokens = append(tokens, token{kind: tokenSymbol, text: ";", line: start})
+			i++
+
+			if isCopyFromStdin(tokens[stmtStart:]) {
+				n, lines, err := skipCopyData(rs[i:])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", start, err)
+				}
+				i += n
+				line += lines
+			}
+			stmtStart = len(tokens)
+		case r == '-' && i+1 < len(rs) && rs[i+1] == '-':
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(rs) && rs[i+1] == '*':
+			// block comments nest in PostgreSQL
+			depth := 0
+			for i < len(rs) {
+				if rs[i] == '/' && i+1 < len(rs) && rs[i+1] == '*' {
+					depth++
+					i += 2
+				} else if rs[i] == '*' && i+1 < len(rs) && rs[i+1] == '/' {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					if rs[i] == '\n' {
+						line++
+					}
+					i++
+				}
+			}
+			if depth > 0 {
+				return nil, fmt.Errorf("line %d: unterminated block comment", start)
+			}
+		case (r == 'e' || r == 'E') && i+1 < len(rs) && rs[i+1] == '\'':
+			text, n, err := scanString(rs[i+1:], true)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", start, err)
+			}
+			line += strings.Count(string(rs[i:i+1+n]), "\n")
+			i += 1 + n
+			tokens = append(tokens, token{kind: tokenString, text: text, line: start})
+		case r == '\'':
+			text, n, err := scanString(rs[i:], false)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", start, err)
+			}
+			line += strings.Count(string(rs[i:i+n]), "\n")
+			i += n
+			tokens = append(tokens, token{kind: tokenString, text: text, line: start})
+		case r == '"':
+			j := i + 1
+			var b strings.Builder
+			for {
+				if j >= len(rs) {
+					return nil, fmt.Errorf("line %d: unterminated quoted identifier", start)
+				}
+				if rs[j] == '"' {
+					if j+1 < len(rs) && rs[j+1] == '"' {
+						b.WriteRune('"')
+						j += 2
+						continue
+					}
+					break
+				}
+				b.WriteRune(rs[j])
+				j++
+			}
+			line += strings.Count(b.String(), "\n")
+			i = j + 1
+			tokens = append(tokens, token{kind: tokenQuotedIdent, text: b.String(), line: start})
+		case r == '$' && i+1 < len(rs) && (rs[i+1] == '$' || isIdentStart(rs[i+1])):
+			// dollar-quoted string, e.g. $$...$$ or $body$...$body$
+			j := i + 1
+			for j < len(rs) && rs[j] != '$' && isIdentPart(rs[j]) {
+				j++
+			}
+			if j >= len(rs) || rs[j] != '$' {
+				// a positional parameter such as $1 does not reach here since digits are not an identifier start
+				return nil, fmt.Errorf("line %d: invalid dollar quote", start)
+			}
+			tag := string(rs[i : j+1])
+			rest := string(rs[j+1:])
+			end := strings.Index(rest, tag)
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated dollar-quoted string", start)
+			}
+			text := rest[:end]
+			line += strings.Count(text, "\n")
+			i = j + 1 + len([]rune(text)) + len([]rune(tag))
+			tokens = append(tokens, token{kind: tokenString, text: text, line: start})
+		case isIdentStart(r):
+			j := i
+			for j < len(rs) && isIdentPart(rs[j]) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: strings.ToLower(string(rs[i:j])), line: start})
+			i = j
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(rs) && unicode.IsDigit(rs[i+1])):
+			j := i
+			for j < len(rs) && (unicode.IsDigit(rs[j]) || rs[j] == '.' || rs[j] == 'e' || rs[j] == 'E' ||
+				((rs[j] == '+' || rs[j] == '-') && (rs[j-1] == 'e' || rs[j-1] == 'E'))) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(rs[i:j]), line: start})
+			i = j
+		case r == ':' && i+1 < len(rs) && rs[i+1] == ':':
+			tokens = append(tokens, token{kind: tokenSymbol, text: "::", line: start})
+			i += 2
+		case strings.ContainsRune(operatorChars, r):
+			j := i
+			for j < len(rs) && strings.ContainsRune(operatorChars, rs[j]) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenSymbol, text: string(rs[i:j]), line: start})
+			i = j
+		default:
+			tokens = append(tokens, token{kind: tokenSymbol, text: string(r), line: start})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+// isCopyFromStdin reports whether the statement is COPY ... FROM stdin, whose data follows the statement.
+func isCopyFromStdin(stmt []token) bool {
+	if len(stmt) == 0 || !stmt[0].isKeyword("copy") {
+		return false
+	}
+
+	for i := 1; i+1 < len(stmt); i++ {
+		if stmt[i].isKeyword("from") && stmt[i+1].isKeyword("stdin") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// skipCopyData skips the rest of the line of COPY ... FROM stdin and the data lines up to the terminating \.
+// and returns the number of runes and newlines consumed.
+func skipCopyData(rs []rune) (int, int, error) {
+	var lines int
+	for i := 0; i < len(rs); {
+		end := i
+		for end < len(rs) && rs[end] != '\n' {
+			end++
+		}
+		isTerminator := lines > 0 && strings.TrimSpace(string(rs[i:end])) == `\.`
+
+		i = end
+		if i < len(rs) {
+			i++
+			lines++
+		}
+
+		if isTerminator {
+			return i, lines, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf(`missing \. terminating COPY data`)
+}
+
+// scanString scans a single-quoted string literal at the beginning of rs
+// and returns the content and the number of runes consumed.
+// Backslash escapes are processed only for escape strings (E'...').
+func scanString(rs []rune, escape bool) (string, int, error) {
+	var b strings.Builder
+	for i := 1; i < len(rs); i++ {
+		switch {
+		case rs[i] == '\'' && i+1 < len(rs) && rs[i+1] == '\'':
+			b.WriteRune('\'')
+			i++
+		case rs[i] == '\'':
+			return b.String(), i + 1, nil
+		case escape && rs[i] == '\\' && i+1 < len(rs):
+			i++
+			switch rs[i] {
+			case 'n':
+				b.WriteRune('\n')
+			case 't':
+				b.WriteRune('\t')
+			case 'r':
+				b.WriteRune('\r')
+			default:
+				b.WriteRune(rs[i])
+			}
+		default:
+			b.WriteRune(rs[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string literal")
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentPart(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// splitStatements splits the tokens into statements by semicolons.
+func splitStatements(tokens []token) [][]token {
+	var (
+		statements [][]token
+		current    []token
+	)
+	for _, t := range tokens {
+		if t.isSymbol(";") {
+			if len(current) > 0 {
+				statements = append(statements, current)
+			}
+			current = nil
+			continue
+		}
+
+		current = append(current, t)
+	}
+	if len(current) > 0 {
+		statements = append(statements, current)
+	}
+
+	return statements
+}
+
+// renderTokens renders the tokens back to SQL with normalized spacing, e.g. "lower(email)", "(price > 0)".
+func renderTokens(tokens []token) string {
+	var b strings.Builder
+	for i, t := range tokens {
+		if i > 0 && needsSpace(tokens[i-1], t) {
+			b.WriteByte(' ')
+		}
+		if t.kind == tokenIdent && upperKeywords[t.text] {
+			b.WriteString(strings.ToUpper(t.text))
+		} else {
+			b.WriteString(t.String())
+		}
+	}
+
+	return b.String()
+}
+
+// upperKeywords are the keywords rendered in upper case as pg_get_indexdef and pg_get_expr do.
+var upperKeywords = map[string]bool{
+	"asc":     true,
+	"desc":    true,
+	"nulls":   true,
+	"first":   true,
+	"last":    true,
+	"collate": true,
+	"and":     true,
+	"or":      true,
+	"not":     true,
+	"is":      true,
+	"null":    true,
+	"in":      true,
+	"like":    true,
+	// SQL value functions written without parentheses
+	"current_date":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"current_user":      true,
+	"session_user":      true,
+}
+
+func needsSpace(prev, cur token) bool {
+	switch {
+	case prev.isSymbol("("), prev.isSymbol("."), prev.isSymbol("::"), prev.isSymbol("["):
+		return false
+	case cur.isSymbol(")"), cur.isSymbol(","), cur.isSymbol("."), cur.isSymbol("::"), cur.isSymbol("["), cur.isSymbol("]"):
+		return false
+	case cur.isSymbol("("):
+		// function calls are written without a space
+		return !prev.isIdent() || (prev.kind == tokenIdent && spacedKeywords[prev.text])
+	default:
+		return true
+	}
+}
+
+// spacedKeywords are keywords followed by a space before a parenthesis, e.g. "IN ('a', 'b')".
+var spacedKeywords = map[string]bool{
+	"and":  true,
+	"or":   true,
+	"not":  true,
+	"in":   true,
+	"is":   true,
+	"as":   true,
+	"when": true,
+	"then": true,
+	"else": true,
+	"like": true,
+}
@@ -0,0 +1,110 @@
+package ddl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []token
+	}{
+		{
+			name: "identifiers are folded to lower case unless quoted",
+			src:  `Users "UserName"`,
+			want: []token{{kind: tokenIdent, text: "users", line: 1}, {kind: tokenQuotedIdent, text: "UserName", line: 1}},
+		},
+		{
+			name: "string literals",
+			src:  "'it''s' E'a\\'b'\n$$x; 'y'$$ $tag$z$tag$",
+			want: []token{
+				{kind: tokenString, text: "it's", line: 1},
+				{kind: tokenString, text: "a'b", line: 1},
+				{kind: tokenString, text: "x; 'y'", line: 2},
+				{kind: tokenString, text: "z", line: 2},
+			},
+		},
+		{
+			name: "comments",
+			src:  "a -- comment\n/* b /* nested */ c */ d",
+			want: []token{{kind: tokenIdent, text: "a", line: 1}, {kind: tokenIdent, text: "d", line: 2}},
+		},
+		{
+			name: "psql meta-commands",
+			src:  "\\connect app\n  \\restrict key\na \\ b",
+			want: []token{
+				{kind: tokenIdent, text: "a", line: 3},
+				{kind: tokenSymbol, text: "\\", line: 3},
+				{kind: tokenIdent, text: "b", line: 3},
+			},
+		},
+		{
+			name: "data of COPY FROM stdin",
+			src:  "COPY t (a) FROM stdin;\n1\tit's; x\n\\.\nb",
+			want: []token{
+				{kind: tokenIdent, text: "copy", line: 1},
+				{kind: tokenIdent, text: "t", line: 1},
+				{kind: tokenSymbol, text: "(", line: 1},
+				{kind: tokenIdent, text: "a", line: 1},
+				{kind: tokenSymbol, text: ")", line: 1},
+				{kind: tokenIdent, text: "from", line: 1},
+				{kind: tokenIdent, text: "stdin", line: 1},
+				{kind: tokenSymbol, text: ";", line: 1},
+				{kind: tokenIdent, text: "b", line: 4},
+			},
+		},
+		{
+			name: "symbols and numbers",
+			src:  "(0)::numeric <> 1.5e-3",
+			want: []token{
+				{kind: tokenSymbol, text: "(", line: 1},
+				{kind: tokenNumber, text: "0", line: 1},
+				{kind: tokenSymbol, text: ")", line: 1},
+				{kind: tokenSymbol, text: "::", line: 1},
+				{kind: tokenIdent, text: "numeric", line: 1},
+				{kind: tokenSymbol, text: "<>", line: 1},
+				{kind: tokenNumber, text: "1.5e-3", line: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tokenize(tt.src)
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRenderTokens(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"lower( email )", "lower(email)"},
+		{"status IN ('a','b')", "status IN ('a', 'b')"},
+		{"(price > (0)::numeric)", "(price > (0)::numeric)"},
+		{`"Name" desc nulls first`, `"Name" DESC NULLS FIRST`},
+		{"current_timestamp", "CURRENT_TIMESTAMP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := tokenize(tt.src)
+			if err != nil {
+				t.Fatalf("failed to tokenize: %v", err)
+			}
+
+			assert.Equal(t, tt.want, renderTokens(tokens))
+		})
+	}
+}
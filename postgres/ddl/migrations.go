@@ -0,0 +1,120 @@
+package ddl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// NewMigrationsSchemaLoader returns a loader replaying the up migrations in the directory in the order of their versions.
+// The following layouts are supported, and the files are distinguished by name and content:
+//   - golang-migrate: "1_create_users.up.sql" and "1_create_users.down.sql". Down migrations are ignored.
+//   - goose: "20240101000000_create_users.sql" with "-- +goose Up" and "-- +goose Down" sections. Only the Up section is applied.
+//   - plain numbered files: "001_create_users.sql". The whole file is applied.
+func NewMigrationsSchemaLoader(dir string, schemas ...string) *SchemaLoader {
+	return newSchemaLoader(schemas, func() ([]source, error) {
+		return readMigrations(dir)
+	})
+}
+
+var (
+	migrationVersionRegex = regexp.MustCompile(`^(\d+)`)
+	gooseAnnotationRegex  = regexp.MustCompile(`(?i)^\s*--\s*\+goose\s+(\w+)`)
+)
+
+type migration struct {
+	version string
+	source
+}
+
+func readMigrations(dir string) ([]source, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	var migrations []migration
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".sql") || strings.HasSuffix(name, ".down.sql") {
+			continue
+		}
+
+		match := migrationVersionRegex.FindStringSubmatch(name)
+		if match == nil {
+			return nil, fmt.Errorf("failed to parse version of migration %s: file name must start with a number", name)
+		}
+
+		path := filepath.Join(dir, name)
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration file: %w", err)
+		}
+
+		migrations = append(migrations, migration{
+			version: strings.TrimLeft(match[1], "0"),
+			source: source{
+				path: path,
+				sql:  gooseUpSection(string(src)),
+			},
+		})
+	}
+
+	// versions are compared as numbers without parsing since timestamps may overflow int64
+	slices.SortStableFunc(migrations, func(a, b migration) int {
+		if len(a.version) != len(b.version) {
+			return len(a.version) - len(b.version)
+		}
+
+		return strings.Compare(a.version, b.version)
+	})
+
+	sources := make([]source, len(migrations))
+	for i, m := range migrations {
+		if i > 0 && migrations[i-1].version == m.version {
+			return nil, fmt.Errorf("duplicate migration version %s: %s and %s", m.version, migrations[i-1].path, m.path)
+		}
+
+		sources[i] = m.source
+	}
+
+	return sources, nil
+}
+
+// gooseUpSection returns the SQL of the "-- +goose Up" section. SQL without goose annotations is returned as is.
+// Lines outside the section are blanked instead of removed to keep line numbers in error messages,
+// and the annotations are kept since they are comments.
+func gooseUpSection(src string) string {
+	lines := strings.Split(src, "\n")
+
+	var (
+		annotated bool
+		inUp      bool
+	)
+	for i, line := range lines {
+		match := gooseAnnotationRegex.FindStringSubmatch(line)
+		if match != nil {
+			switch strings.ToLower(match[1]) {
+			case "up":
+				annotated, inUp = true, true
+			case "down":
+				annotated, inUp = true, false
+			}
+			// other annotations such as StatementBegin are comments for the parser
+			continue
+		}
+
+		if !inUp {
+			lines[i] = ""
+		}
+	}
+
+	if !annotated {
+		return src
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,95 @@
+package ddl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kmtym1998/chair/generator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTableSchemas_Migrations(t *testing.T) {
+	expected := []generator.Table{
+		{
+			Schema: "public",
+			Name:   "posts",
+			Kind:   generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "bigint", IsNullable: false, OrderAsc: 1, Default: "nextval('posts_id_seq'::regclass)"},
+				{Name: "user_id", Type: "bigint", IsNullable: false, OrderAsc: 2},
+				{Name: "body", Type: "text", IsNullable: false, OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+			Indexes: []generator.Index{
+				{Name: "posts_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX posts_pkey ON public.posts USING btree (id)"},
+			},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "posts_user_id_fkey", Columns: []string{"user_id"}, RefSchema: "public", RefTable: "users", RefColumns: []string{"id"}, OnDelete: generator.ForeignKeyActionNoAction, OnUpdate: generator.ForeignKeyActionNoAction},
+			},
+		},
+		{
+			Schema: "public",
+			Name:   "users",
+			Kind:   generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "bigint", IsNullable: false, OrderAsc: 1, Default: "nextval('users_id_seq'::regclass)"},
+				{Name: "name", Type: "text", IsNullable: false, OrderAsc: 2},
+			},
+			PrimaryKey: []string{"id"},
+			Indexes: []generator.Index{
+				{Name: "users_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX users_pkey ON public.users USING btree (id)"},
+			},
+		},
+	}
+
+	for _, layout := range []string{"golang-migrate", "goose", "plain"} {
+		t.Run(layout, func(t *testing.T) {
+			t.Parallel()
+
+			ldr := NewMigrationsSchemaLoader(filepath.Join("testdata", "migrations", layout), "")
+			actual, err := ldr.LoadTableSchemas(context.Background())
+			if err != nil {
+				t.Fatalf("failed to load table schemas: %v", err)
+			}
+
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestReadMigrations_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   []string
+		wantErr string
+	}{
+		{"file without version", []string{"create_users.sql"}, "file name must start with a number"},
+		{"duplicate version", []string{"1_create_users.up.sql", "01_create_posts.up.sql"}, "duplicate migration version 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			for _, name := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), nil, 0o600); err != nil {
+					t.Fatalf("failed to write migration file: %v", err)
+				}
+			}
+
+			_, err := readMigrations(dir)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGooseUpSection(t *testing.T) {
+	src := "-- +goose Up\nCREATE TABLE a (id int);\n-- +goose Down\nDROP TABLE a;\n"
+
+	assert.Equal(t, "-- +goose Up\nCREATE TABLE a (id int);\n-- +goose Down\n\n", gooseUpSection(src))
+	assert.Equal(t, "CREATE TABLE a (id int);", gooseUpSection("CREATE TABLE a (id int);"))
+}
@@ -0,0 +1,1660 @@
+package ddl
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+// peek returns the current token. An empty symbol is returned at the end of the statement.
+func (p *parser) peek() token {
+	return p.peekAt(0)
+}
+
+func (p *parser) peekAt(n int) token {
+	if p.pos+n >= len(p.tokens) {
+		return token{kind: tokenSymbol}
+	}
+
+	return p.tokens[p.pos+n]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	if !p.eof() {
+		p.pos++
+	}
+
+	return t
+}
+
+// isKeyword reports whether the following tokens are the keywords without consuming them.
+func (p *parser) isKeyword(kws ...string) bool {
+	for i, kw := range kws {
+		if !p.peekAt(i).isKeyword(kw) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// acceptKeyword consumes the keywords if the following tokens are the keywords.
+func (p *parser) acceptKeyword(kws ...string) bool {
+	if !p.isKeyword(kws...) {
+		return false
+	}
+	p.pos += len(kws)
+
+	return true
+}
+
+func (p *parser) expectKeyword(kws ...string) error {
+	if !p.acceptKeyword(kws...) {
+		return p.errorf("expected %s", strings.ToUpper(strings.Join(kws, " ")))
+	}
+
+	return nil
+}
+
+func (p *parser) acceptSymbol(s string) bool {
+	if !p.peek().isSymbol(s) {
+		return false
+	}
+	p.pos++
+
+	return true
+}
+
+func (p *parser) expectSymbol(s string) error {
+	if !p.acceptSymbol(s) {
+		return p.errorf("expected %q", s)
+	}
+
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	t := p.peek()
+	if p.eof() && len(p.tokens) > 0 {
+		t = p.tokens[len(p.tokens)-1]
+		return fmt.Errorf("line %d: %s at end of statement", t.line, fmt.Sprintf(format, args...))
+	}
+
+	return fmt.Errorf("line %d: %s near %q", t.line, fmt.Sprintf(format, args...), t.String())
+}
+
+func (p *parser) ident() (string, error) {
+	t := p.peek()
+	if !t.isIdent() {
+		return "", p.errorf("expected identifier")
+	}
+	p.pos++
+
+	return t.text, nil
+}
+
+// qualifiedName parses a possibly schema-qualified name. The database part of a three-part name is ignored.
+func (p *parser) qualifiedName() (schema, name string, err error) {
+	parts := make([]string, 0, 3)
+	for {
+		part, err := p.ident()
+		if err != nil {
+			return "", "", err
+		}
+		parts = append(parts, part)
+
+		if !p.peek().isSymbol(".") || !p.peekAt(1).isIdent() {
+			break
+		}
+		p.pos++
+	}
+
+	if len(parts) == 1 {
+		return "", parts[0], nil
+	}
+
+	return parts[len(parts)-2], parts[len(parts)-1], nil
+}
+
+// identList parses a parenthesized list of identifiers, e.g. (a, b).
+func (p *parser) identList() ([]string, error) {
+	if err := p.expectSymbol("("); err != nil {
+		return nil, err
+	}
+
+	var idents []string
+	for {
+		ident, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		idents = append(idents, ident)
+
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+
+	if err := p.expectSymbol(")"); err != nil {
+		return nil, err
+	}
+
+	return idents, nil
+}
+
+// group consumes a parenthesized group and returns the tokens inside the parentheses.
+func (p *parser) group() ([]token, error) {
+	if err := p.expectSymbol("("); err != nil {
+		return nil, err
+	}
+
+	start := p.pos
+	depth := 1
+	for !p.eof() {
+		t := p.next()
+		switch {
+		case t.isSymbol("("):
+			depth++
+		case t.isSymbol(")"):
+			depth--
+			if depth == 0 {
+				return p.tokens[start : p.pos-1], nil
+			}
+		}
+	}
+
+	return nil, p.errorf("unbalanced parentheses")
+}
+
+// skipUntil consumes tokens until a comma or a closing parenthesis at the current depth, or a token matching stop.
+func (p *parser) skipUntil(stop func(token) bool) []token {
+	start := p.pos
+	depth := 0
+	for !p.eof() {
+		t := p.peek()
+		if depth == 0 && (t.isSymbol(",") || t.isSymbol(")") || (stop != nil && stop(t))) {
+			break
+		}
+
+		switch {
+		case t.isSymbol("("), t.isSymbol("["):
+			depth++
+		case t.isSymbol(")"), t.isSymbol("]"):
+			depth--
+		}
+		p.pos++
+	}
+
+	return p.tokens[start:p.pos]
+}
+
+// skipBrackets consumes an array bound such as [] or [3].
+func (p *parser) skipBrackets() {
+	for !p.eof() {
+		if p.next().isSymbol("]") {
+			return
+		}
+	}
+}
+
+// splitByComma splits the tokens by commas at the top level.
+func splitByComma(tokens []token) [][]token {
+	var (
+		parts [][]token
+		start int
+		depth int
+	)
+	for i, t := range tokens {
+		switch {
+		case t.isSymbol("("), t.isSymbol("["):
+			depth++
+		case t.isSymbol(")"), t.isSymbol("]"):
+			depth--
+		case t.isSymbol(",") && depth == 0:
+			parts = append(parts, tokens[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, tokens[start:])
+}
+
+// apply applies a statement to the catalog. Statements which do not affect tables and enums are ignored.
+func (c *catalog) apply(tokens []token) error {
+	p := &parser{tokens: tokens}
+
+	switch {
+	case p.acceptKeyword("create"):
+		p.acceptKeyword("or", "replace")
+		return c.applyCreate(p)
+	case p.acceptKeyword("alter", "table"):
+		return c.applyAlterTable(p)
+	case p.acceptKeyword("alter", "type"):
+		return c.applyAlterType(p)
+	case p.acceptKeyword("alter", "index"):
+		return c.applyAlterIndex(p)
+	case p.acceptKeyword("alter", "domain"):
+		return c.applyAlterDomain(p)
+	case p.acceptKeyword("drop"):
+		return c.applyDrop(p)
+	case p.acceptKeyword("comment", "on"):
+		return c.applyComment(p)
+	case p.isKeyword("set"):
+		return c.applySet(p)
+	case p.isKeyword("select"):
+		return c.applySelect(p)
+	default:
+		return nil
+	}
+}
+
+func (c *catalog) applyCreate(p *parser) error {
+	switch {
+	case p.acceptKeyword("unique", "index"):
+		return c.applyCreateIndex(p, true)
+	case p.acceptKeyword("index"):
+		return c.applyCreateIndex(p, false)
+	case p.acceptKeyword("type"):
+		return c.applyCreateType(p)
+	case p.acceptKeyword("domain"):
+		return c.applyCreateDomain(p)
+	case p.acceptKeyword("schema"):
+		return applyCreateSchema(p)
+	}
+
+	p.acceptKeyword("global")
+	p.acceptKeyword("local")
+	_ = p.acceptKeyword("temporary") || p.acceptKeyword("temp") || p.acceptKeyword("unlogged")
+
+	switch {
+	case p.acceptKeyword("table"):
+		return c.applyCreateTable(p)
+	case p.acceptKeyword("view"), p.acceptKeyword("recursive", "view"), p.acceptKeyword("materialized", "view"):
+		p.acceptKeyword("if", "not", "exists")
+		schema, name, err := p.qualifiedName()
+		if err != nil {
+			return err
+		}
+
+		slog.Warn("views are not loaded from DDL since their column types cannot be determined", "view", c.qualify(schema, name))
+		c.skipped[c.qualify(schema, name)] = true
+
+		return nil
+	default:
+		return nil
+	}
+}
+
+// lookupTable finds the table. nil is returned without an error when the table is not loaded on purpose,
+// such as views and partitions, or when it does not exist and ifExists is true.
+func (c *catalog) lookupTable(p *parser, name qualifiedName, ifExists bool) (*table, error) {
+	if t := c.table(name); t != nil {
+		return t, nil
+	}
+
+	if c.skipped[name] || ifExists {
+		return nil, nil
+	}
+
+	return nil, p.errorf("table %s does not exist", name)
+}
+
+func (c *catalog) applyCreateTable(p *parser) error {
+	ifNotExists := p.acceptKeyword("if", "not", "exists")
+
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	tableName := c.qualify(schema, name)
+	if p.isKeyword("partition", "of") {
+		// the columns of a partition are those of the partitioned table, which is loaded instead
+		slog.Debug("skipping partition", "table", tableName)
+		c.skipped[tableName] = true
+
+		return nil
+	}
+	if p.isKeyword("of") {
+		return p.errorf("typed table %s is not supported since composite types are not loaded", tableName)
+	}
+
+	if c.table(tableName) != nil {
+		if ifNotExists {
+			return nil
+		}
+
+		return p.errorf("table %s already exists", tableName)
+	}
+
+	t := &table{name: tableName}
+	if err := p.expectSymbol("("); err != nil {
+		return err
+	}
+
+	if !p.acceptSymbol(")") {
+		for {
+			if err := c.parseTableElement(p, t); err != nil {
+				return err
+			}
+
+			if p.acceptSymbol(")") {
+				break
+			}
+			if err := p.expectSymbol(","); err != nil {
+				return err
+			}
+		}
+	}
+
+	for !p.eof() {
+		switch {
+		case p.acceptKeyword("inherits"):
+			if err := c.parseInherits(p, t); err != nil {
+				return err
+			}
+		case p.acceptKeyword("partition", "by"):
+			p.next()
+			if _, err := p.group(); err != nil {
+				return err
+			}
+		case p.acceptKeyword("using"), p.acceptKeyword("tablespace"):
+			if _, err := p.ident(); err != nil {
+				return err
+			}
+		case p.acceptKeyword("with"):
+			if _, err := p.group(); err != nil {
+				return err
+			}
+		case p.acceptKeyword("without", "oids"):
+		case p.acceptKeyword("on", "commit"):
+			if !p.acceptKeyword("preserve", "rows") && !p.acceptKeyword("delete", "rows") && !p.acceptKeyword("drop") {
+				return p.errorf("unexpected ON COMMIT action")
+			}
+		default:
+			return p.errorf("unsupported clause in CREATE TABLE")
+		}
+	}
+
+	c.tables = append(c.tables, t)
+
+	return nil
+}
+
+// parseInherits parses the parents of INHERITS and copies their columns to the table.
+func (c *catalog) parseInherits(p *parser, t *table) error {
+	if err := p.expectSymbol("("); err != nil {
+		return err
+	}
+
+	var parents []*table
+	for {
+		schema, name, err := p.qualifiedName()
+		if err != nil {
+			return err
+		}
+
+		parent := c.table(c.qualify(schema, name))
+		if parent == nil {
+			return p.errorf("table %s to inherit from does not exist", c.qualify(schema, name))
+		}
+		parents = append(parents, parent)
+
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+
+	if err := p.expectSymbol(")"); err != nil {
+		return err
+	}
+
+	c.inherit(t, parents)
+
+	return nil
+}
+
+// isTableConstraint reports whether the parser is at the beginning of a table constraint.
+func (p *parser) isTableConstraint() bool {
+	return p.isKeyword("constraint") || p.isKeyword("primary", "key") || p.isKeyword("unique") ||
+		p.isKeyword("foreign", "key") || p.isKeyword("check") || p.isKeyword("exclude")
+}
+
+func (c *catalog) parseTableElement(p *parser, t *table) error {
+	switch {
+	case p.isTableConstraint():
+		return c.parseTableConstraint(p, t)
+	case p.acceptKeyword("like"):
+		schema, name, err := p.qualifiedName()
+		if err != nil {
+			return err
+		}
+
+		source, err := c.lookupTable(p, c.qualify(schema, name), false)
+		if err != nil || source == nil {
+			return err
+		}
+
+		// only columns are copied since constraints and indexes require INCLUDING options
+		for _, col := range source.columns {
+			copied := *col
+			copied.isLocal = true
+			copied.inhCount = 0
+			t.addColumn(&copied)
+		}
+		p.skipUntil(nil)
+
+		return nil
+	default:
+		return c.parseColumnDefinition(p, t)
+	}
+}
+
+// columnConstraintKeywords are the keywords starting a column constraint, which terminate a DEFAULT expression.
+var columnConstraintKeywords = map[string]bool{
+	"constraint": true,
+	"not":        true,
+	"null":       true,
+	"primary":    true,
+	"unique":     true,
+	"references": true,
+	"check":      true,
+	"default":    true,
+	"generated":  true,
+	"collate":    true,
+	"deferrable": true,
+	"initially":  true,
+}
+
+func (c *catalog) parseColumnDefinition(p *parser, t *table) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	typ, err := p.parseType()
+	if err != nil {
+		return err
+	}
+
+	col := &column{
+		name:       name,
+		typ:        typ,
+		isNullable: !typ.isSerial(),
+		isLocal:    true,
+	}
+	if typ.isSerial() {
+		col.defaultExpr = serialDefault(t.name, name)
+	}
+
+	for !p.eof() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+		var constraintName string
+		if p.acceptKeyword("constraint") {
+			if constraintName, err = p.ident(); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case p.acceptKeyword("not", "null"):
+			col.isNullable = false
+		case p.acceptKeyword("null"):
+			col.isNullable = true
+		case p.acceptKeyword("primary", "key"):
+			col.isNullable = false
+			t.primaryKey = &constraint{
+				name:    defaultName(constraintName, t.name.name, nil, "pkey"),
+				columns: []string{name},
+			}
+		case p.acceptKeyword("unique"):
+			if p.acceptKeyword("nulls") {
+				p.acceptKeyword("not")
+				if err := p.expectKeyword("distinct"); err != nil {
+					return err
+				}
+			}
+			t.uniques = append(t.uniques, &constraint{
+				name:    defaultName(constraintName, t.name.name, []string{name}, "key"),
+				columns: []string{name},
+			})
+		case p.acceptKeyword("references"):
+			fk, err := c.parseReferences(p)
+			if err != nil {
+				return err
+			}
+			fk.name = defaultName(constraintName, t.name.name, []string{name}, "fkey")
+			fk.columns = []string{name}
+			t.foreignKeys = append(t.foreignKeys, fk)
+		case p.acceptKeyword("default"):
+			if col.defaultExpr, err = parseDefault(p); err != nil {
+				return err
+			}
+		case p.acceptKeyword("check"):
+			if _, err := p.group(); err != nil {
+				return err
+			}
+			p.acceptKeyword("no", "inherit")
+		case p.acceptKeyword("generated"):
+			if err := parseGenerated(p, col); err != nil {
+				return err
+			}
+		case p.acceptKeyword("collate"):
+			if _, _, err := p.qualifiedName(); err != nil {
+				return err
+			}
+		case p.acceptKeyword("deferrable"), p.acceptKeyword("not", "deferrable"):
+		case p.acceptKeyword("initially"):
+			p.next()
+		default:
+			return p.errorf("unexpected token in column definition")
+		}
+	}
+
+	t.addColumn(col)
+
+	return nil
+}
+
+// parseDefault parses the expression of DEFAULT up to the next column constraint, and returns it rendered.
+func parseDefault(p *parser) (string, error) {
+	start := p.pos
+	if p.peek().isSymbol("(") {
+		if _, err := p.group(); err != nil {
+			return "", err
+		}
+	} else {
+		p.next()
+	}
+	p.skipUntil(func(t token) bool {
+		return t.kind == tokenIdent && columnConstraintKeywords[t.text]
+	})
+
+	return renderTokens(p.tokens[start:p.pos]), nil
+}
+
+// parseGenerated parses the rest of GENERATED {ALWAYS | BY DEFAULT} AS {IDENTITY [(options)] | (expr) STORED}
+// into the column. Identity columns are NOT NULL.
+func parseGenerated(p *parser, col *column) error {
+	identity, err := parseIdentityGeneration(p)
+	if err != nil {
+		return err
+	}
+	if err := p.expectKeyword("as"); err != nil {
+		return err
+	}
+
+	if p.acceptKeyword("identity") {
+		if p.peek().isSymbol("(") {
+			if _, err := p.group(); err != nil {
+				return err
+			}
+		}
+		col.identity = identity
+		col.isNullable = false
+		col.defaultExpr = ""
+
+		return nil
+	}
+
+	if _, err := p.group(); err != nil {
+		return err
+	}
+	p.acceptKeyword("stored")
+	col.isGenerated = true
+
+	return nil
+}
+
+// parseIdentityGeneration parses ALWAYS or BY DEFAULT and returns it in upper case.
+func parseIdentityGeneration(p *parser) (string, error) {
+	if p.acceptKeyword("always") {
+		return "ALWAYS", nil
+	}
+	if err := p.expectKeyword("by", "default"); err != nil {
+		return "", err
+	}
+
+	return "BY DEFAULT", nil
+}
+
+// serialDefault returns the default of the serial column, which is the next value of the sequence named after the
+// table and the column. The sequence is qualified by the schema outside of the public schema as pg_get_expr does.
+func serialDefault(table qualifiedName, columnName string) string {
+	sequence := table.name + "_" + columnName + "_seq"
+	if table.schema != defaultSchema {
+		sequence = table.schema + "." + sequence
+	}
+
+	return "nextval(" + token{kind: tokenString, text: sequence}.String() + "::regclass)"
+}
+
+// typeContinuationKeywords are the words following the first word of a multi-word type name,
+// e.g. "double precision", "timestamp with time zone", "interval day to second".
+var typeContinuationKeywords = map[string]bool{
+	"precision": true,
+	"varying":   true,
+	"with":      true,
+	"without":   true,
+	"time":      true,
+	"zone":      true,
+	"year":      true,
+	"month":     true,
+	"day":       true,
+	"hour":      true,
+	"minute":    true,
+	"second":    true,
+	"to":        true,
+}
+
+func (p *parser) parseType() (typeRef, error) {
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return typeRef{}, err
+	}
+
+	typ := typeRef{schema: schema}
+	words := []string{name}
+	for {
+		switch t := p.peek(); {
+		case t.isSymbol("("):
+			modifiers, err := p.group()
+			if err != nil {
+				return typeRef{}, err
+			}
+			for _, part := range splitByComma(modifiers) {
+				if len(part) > 0 {
+					typ.modifiers = append(typ.modifiers, part[0])
+				}
+			}
+		case t.isSymbol("["):
+			p.skipBrackets()
+			typ.arrayDims++
+		case t.isKeyword("array"):
+			p.next()
+			typ.arrayDims++
+			// the size of ARRAY[n] does not add a dimension
+			if p.peek().isSymbol("[") {
+				p.skipBrackets()
+			}
+		case t.kind == tokenIdent && typeContinuationKeywords[t.text]:
+			words = append(words, t.text)
+			p.next()
+		default:
+			typ.name = strings.Join(words, " ")
+			return typ, nil
+		}
+	}
+}
+
+// parseReferences parses the rest of REFERENCES reftable [(refcolumns)] [MATCH type] [ON DELETE action] [ON UPDATE action]
+// [[NOT] DEFERRABLE] [INITIALLY {DEFERRED | IMMEDIATE}].
+func (c *catalog) parseReferences(p *parser) (*foreignKey, error) {
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return nil, err
+	}
+
+	fk := &foreignKey{refTable: c.qualify(schema, name), onDelete: "NO ACTION", onUpdate: "NO ACTION"}
+	if p.peek().isSymbol("(") {
+		if fk.refColumns, err = p.identList(); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		switch {
+		case p.acceptKeyword("match"):
+			p.next()
+		case p.acceptKeyword("on", "delete"):
+			if fk.onDelete, err = parseReferentialAction(p); err != nil {
+				return nil, err
+			}
+		case p.acceptKeyword("on", "update"):
+			if fk.onUpdate, err = parseReferentialAction(p); err != nil {
+				return nil, err
+			}
+		case p.acceptKeyword("deferrable"):
+			fk.isDeferrable = true
+		case p.acceptKeyword("not", "deferrable"):
+			fk.isDeferrable = false
+		case p.acceptKeyword("initially", "deferred"):
+			fk.isInitiallyDeferred = true
+		case p.acceptKeyword("initially", "immediate"):
+			fk.isInitiallyDeferred = false
+		default:
+			return fk, nil
+		}
+	}
+}
+
+// parseReferentialAction parses the action of ON DELETE or ON UPDATE and returns it in upper case, e.g. "SET NULL".
+func parseReferentialAction(p *parser) (string, error) {
+	for _, kws := range [][]string{{"no", "action"}, {"restrict"}, {"cascade"}, {"set", "null"}, {"set", "default"}} {
+		if !p.acceptKeyword(kws...) {
+			continue
+		}
+
+		// SET NULL and SET DEFAULT may limit the columns to set
+		if kws[0] == "set" && p.peek().isSymbol("(") {
+			if _, err := p.identList(); err != nil {
+				return "", err
+			}
+		}
+
+		return strings.ToUpper(strings.Join(kws, " ")), nil
+	}
+
+	return "", p.errorf("unexpected referential action")
+}
+
+func (c *catalog) parseTableConstraint(p *parser, t *table) error {
+	var (
+		constraintName string
+		err            error
+	)
+	if p.acceptKeyword("constraint") {
+		if constraintName, err = p.ident(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case p.acceptKeyword("primary", "key"):
+		columns, indexName, err := parseConstraintColumns(p, t)
+		if err != nil {
+			return err
+		}
+		if constraintName == "" {
+			constraintName = indexName
+		}
+		t.primaryKey = &constraint{
+			name:    defaultName(constraintName, t.name.name, nil, "pkey"),
+			columns: columns,
+		}
+	case p.acceptKeyword("unique"):
+		if p.acceptKeyword("nulls") {
+			p.acceptKeyword("not")
+			if err := p.expectKeyword("distinct"); err != nil {
+				return err
+			}
+		}
+		columns, indexName, err := parseConstraintColumns(p, t)
+		if err != nil {
+			return err
+		}
+		if constraintName == "" {
+			constraintName = indexName
+		}
+		t.uniques = append(t.uniques, &constraint{
+			name:    defaultName(constraintName, t.name.name, columns, "key"),
+			columns: columns,
+		})
+	case p.acceptKeyword("foreign", "key"):
+		columns, err := p.identList()
+		if err != nil {
+			return err
+		}
+		if err := p.expectKeyword("references"); err != nil {
+			return err
+		}
+		fk, err := c.parseReferences(p)
+		if err != nil {
+			return err
+		}
+		fk.name = defaultName(constraintName, t.name.name, columns, "fkey")
+		fk.columns = columns
+		t.foreignKeys = append(t.foreignKeys, fk)
+	}
+
+	// CHECK, EXCLUDE and the rest such as INCLUDE and NOT VALID are ignored
+	p.skipUntil(nil)
+
+	return nil
+}
+
+// parseConstraintColumns parses the columns of a primary key or unique constraint.
+// With USING INDEX, the existing index becomes the index of the constraint and its name is returned,
+// which is used as the constraint name when it is not given.
+func parseConstraintColumns(p *parser, t *table) ([]string, string, error) {
+	if !p.acceptKeyword("using", "index") {
+		columns, err := p.identList()
+		return columns, "", err
+	}
+
+	indexName, err := p.ident()
+	if err != nil {
+		return nil, "", err
+	}
+
+	i := slices.IndexFunc(t.indexes, func(idx *index) bool {
+		return idx.name == indexName
+	})
+	if i < 0 {
+		return nil, "", p.errorf("index %s does not exist", indexName)
+	}
+
+	columns := make([]string, 0, len(t.indexes[i].keys))
+	for _, key := range t.indexes[i].keys {
+		columns = append(columns, key.column)
+	}
+	t.indexes = slices.Delete(t.indexes, i, i+1)
+
+	return columns, indexName, nil
+}
+
+// defaultName returns the constraint name, or the name PostgreSQL generates when it is not given,
+// e.g. "users_pkey", "users_email_key" and "posts_user_id_fkey".
+func defaultName(name, tableName string, columns []string, suffix string) string {
+	if name != "" {
+		return name
+	}
+
+	parts := append([]string{tableName}, columns...)
+
+	return strings.Join(append(parts, suffix), "_")
+}
+
+func (c *catalog) applyCreateIndex(p *parser, isUnique bool) error {
+	p.acceptKeyword("concurrently")
+	ifNotExists := p.acceptKeyword("if", "not", "exists")
+
+	var (
+		indexName string
+		err       error
+	)
+	if !p.isKeyword("on") {
+		if indexName, err = p.ident(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.expectKeyword("on"); err != nil {
+		return err
+	}
+	p.acceptKeyword("only")
+
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	t, err := c.lookupTable(p, c.qualify(schema, name), false)
+	if err != nil || t == nil {
+		return err
+	}
+
+	idx := &index{
+		isUnique: isUnique,
+		method:   "btree",
+	}
+	if p.acceptKeyword("using") {
+		if idx.method, err = p.ident(); err != nil {
+			return err
+		}
+	}
+
+	keys, err := p.group()
+	if err != nil {
+		return err
+	}
+	for _, part := range splitByComma(keys) {
+		key := indexKey{tokens: part}
+		// a key is a column when it is a single identifier optionally followed by options such as DESC
+		if len(part) > 0 && part[0].isIdent() && (len(part) == 1 || part[1].isIdent()) {
+			key.column = part[0].text
+		}
+		idx.keys = append(idx.keys, key)
+	}
+
+	for !p.eof() {
+		switch {
+		case p.acceptKeyword("where"):
+			idx.where = p.tokens[p.pos:]
+			p.pos = len(p.tokens)
+		case p.acceptKeyword("include"), p.acceptKeyword("with"):
+			if _, err := p.group(); err != nil {
+				return err
+			}
+		default:
+			p.next()
+		}
+	}
+
+	if indexName == "" {
+		indexName = defaultIndexName(t.name.name, idx)
+	}
+	idx.name = indexName
+
+	if existing, _ := c.index(qualifiedName{schema: t.name.schema, name: indexName}); existing != nil {
+		if ifNotExists {
+			return nil
+		}
+
+		return fmt.Errorf("line %d: index %s already exists", p.tokens[0].line, indexName)
+	}
+
+	t.indexes = append(t.indexes, idx)
+
+	return nil
+}
+
+// defaultIndexName returns the index name PostgreSQL generates, e.g. "users_email_idx" and "users_lower_idx".
+func defaultIndexName(tableName string, idx *index) string {
+	parts := []string{tableName}
+	for _, key := range idx.keys {
+		switch {
+		case key.column != "":
+			parts = append(parts, key.column)
+		case len(key.tokens) > 0 && key.tokens[0].isIdent():
+			parts = append(parts, key.tokens[0].text)
+		default:
+			parts = append(parts, "expr")
+		}
+	}
+
+	return strings.Join(append(parts, "idx"), "_")
+}
+
+func (c *catalog) applyCreateType(p *parser) error {
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	// composite, range and base types are ignored
+	if !p.acceptKeyword("as", "enum") {
+		return nil
+	}
+
+	e := &enum{name: c.qualify(schema, name)}
+	if c.typeExists(e.name) {
+		return p.errorf("type %s already exists", e.name)
+	}
+
+	values, err := p.group()
+	if err != nil {
+		return err
+	}
+	for _, part := range splitByComma(values) {
+		if len(part) == 0 {
+			continue
+		}
+		if len(part) != 1 || part[0].kind != tokenString {
+			return p.errorf("expected string literal for enum label")
+		}
+		e.values = append(e.values, part[0].text)
+	}
+
+	c.enums = append(c.enums, e)
+
+	return nil
+}
+
+// applyCreateDomain applies CREATE DOMAIN name [AS] data_type [COLLATE collation] [DEFAULT expr] [constraint ...].
+func (c *catalog) applyCreateDomain(p *parser) error {
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	d := &domain{name: c.qualify(schema, name)}
+	if c.typeExists(d.name) {
+		return p.errorf("type %s already exists", d.name)
+	}
+
+	p.acceptKeyword("as")
+	if d.typ, err = p.parseType(); err != nil {
+		return err
+	}
+
+	for !p.eof() {
+		if p.acceptKeyword("constraint") {
+			if _, err := p.ident(); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case p.acceptKeyword("not", "null"):
+			d.notNull = true
+		case p.acceptKeyword("null"):
+			d.notNull = false
+		case p.acceptKeyword("check"):
+			if _, err := p.group(); err != nil {
+				return err
+			}
+		case p.acceptKeyword("default"):
+			// the default of the domain is not the default of the columns as pg_attrdef has it
+			if _, err := parseDefault(p); err != nil {
+				return err
+			}
+		case p.acceptKeyword("collate"):
+			if _, _, err := p.qualifiedName(); err != nil {
+				return err
+			}
+		default:
+			return p.errorf("unexpected token in domain definition")
+		}
+	}
+
+	c.domains = append(c.domains, d)
+
+	return nil
+}
+
+// applyAlterDomain applies the actions of ALTER DOMAIN which change the columns of the domain.
+func (c *catalog) applyAlterDomain(p *parser) error {
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	d := c.domain(c.qualify(schema, name))
+	if d == nil {
+		return p.errorf("type %s does not exist", c.qualify(schema, name))
+	}
+
+	switch {
+	case p.acceptKeyword("set", "not", "null"):
+		d.notNull = true
+	case p.acceptKeyword("drop", "not", "null"):
+		d.notNull = false
+	case p.acceptKeyword("add"):
+		if p.acceptKeyword("constraint") {
+			if _, err := p.ident(); err != nil {
+				return err
+			}
+		}
+		if p.acceptKeyword("not", "null") {
+			d.notNull = true
+		}
+	case p.acceptKeyword("rename", "to"):
+		newName, err := p.ident()
+		if err != nil {
+			return err
+		}
+		c.renameType(d.name, qualifiedName{schema: d.name.schema, name: newName})
+		d.name.name = newName
+	case p.acceptKeyword("set", "schema"):
+		newSchema, err := p.ident()
+		if err != nil {
+			return err
+		}
+		c.renameType(d.name, qualifiedName{schema: newSchema, name: d.name.name})
+		d.name.schema = newSchema
+	}
+
+	// other actions such as SET DEFAULT, ADD CHECK and OWNER TO do not change the columns
+	return nil
+}
+
+// applyCreateSchema applies CREATE SCHEMA, which has nothing to load unless it creates objects in the schema.
+func applyCreateSchema(p *parser) error {
+	p.acceptKeyword("if", "not", "exists")
+	if !p.isKeyword("authorization") {
+		if _, err := p.ident(); err != nil {
+			return err
+		}
+	}
+	if p.acceptKeyword("authorization") {
+		p.next()
+	}
+
+	if !p.eof() {
+		return p.errorf("schema elements of CREATE SCHEMA are not supported")
+	}
+
+	return nil
+}
+
+func (c *catalog) applyAlterTable(p *parser) error {
+	ifExists := p.acceptKeyword("if", "exists")
+	only := p.acceptKeyword("only")
+
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return err
+	}
+	p.acceptSymbol("*")
+
+	t, err := c.lookupTable(p, c.qualify(schema, name), ifExists)
+	if err != nil || t == nil {
+		return err
+	}
+
+	if p.acceptKeyword("rename") {
+		return c.applyRename(p, t, only)
+	}
+
+	if p.acceptKeyword("set", "schema") {
+		newSchema, err := p.ident()
+		if err != nil {
+			return err
+		}
+		c.renameTableSchema(t, newSchema)
+
+		return nil
+	}
+
+	for {
+		if err := c.applyAlterTableAction(p, t, only); err != nil {
+			return err
+		}
+		p.skipUntil(nil)
+
+		if !p.acceptSymbol(",") {
+			return nil
+		}
+	}
+}
+
+func (c *catalog) applyRename(p *parser, t *table, only bool) error {
+	switch {
+	case p.acceptKeyword("to"):
+		newName, err := p.ident()
+		if err != nil {
+			return err
+		}
+		c.renameTable(t, newName)
+	case p.acceptKeyword("constraint"):
+		oldName, err := p.ident()
+		if err != nil {
+			return err
+		}
+		if err := p.expectKeyword("to"); err != nil {
+			return err
+		}
+		newName, err := p.ident()
+		if err != nil {
+			return err
+		}
+		t.renameConstraint(oldName, newName)
+	default:
+		p.acceptKeyword("column")
+		oldName, err := p.ident()
+		if err != nil {
+			return err
+		}
+		if err := p.expectKeyword("to"); err != nil {
+			return err
+		}
+		newName, err := p.ident()
+		if err != nil {
+			return err
+		}
+		if t.column(oldName) == nil {
+			return p.errorf("column %s of table %s does not exist", oldName, t.name)
+		}
+		c.renameColumn(t, oldName, newName)
+
+		if !only {
+			for _, d := range c.descendants(t) {
+				if d.column(oldName) != nil {
+					c.renameColumn(d, oldName, newName)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *catalog) renameTableSchema(t *table, newSchema string) {
+	oldName := t.name
+	t.name.schema = newSchema
+	c.replaceTableReferences(oldName, t.name)
+}
+
+// applyAlterTableAction applies an action of ALTER TABLE. Changes of columns are applied to the tables inheriting
+// from the table as well unless only is true.
+func (c *catalog) applyAlterTableAction(p *parser, t *table, only bool) error {
+	switch {
+	case p.acceptKeyword("add"):
+		if p.isTableConstraint() {
+			return c.parseTableConstraint(p, t)
+		}
+
+		p.acceptKeyword("column")
+		if p.acceptKeyword("if", "not", "exists") && p.peek().isIdent() && t.column(p.peek().text) != nil {
+			return nil
+		}
+
+		if err := c.parseColumnDefinition(p, t); err != nil {
+			return err
+		}
+		if !only {
+			c.addInheritedColumn(t, t.columns[len(t.columns)-1])
+		}
+	case p.acceptKeyword("drop", "constraint"):
+		p.acceptKeyword("if", "exists")
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		t.dropConstraint(name)
+	case p.acceptKeyword("drop"):
+		p.acceptKeyword("column")
+		ifExists := p.acceptKeyword("if", "exists")
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		if t.column(name) == nil {
+			if ifExists {
+				return nil
+			}
+
+			return p.errorf("column %s of table %s does not exist", name, t.name)
+		}
+		t.dropColumn(name)
+		c.dropInheritedColumn(t, name, only)
+	case p.acceptKeyword("alter"):
+		p.acceptKeyword("column")
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		col := t.column(name)
+		if col == nil {
+			return p.errorf("column %s of table %s does not exist", name, t.name)
+		}
+
+		// identities are not inherited
+		isInherited := !only && !p.isKeyword("add", "generated") && !p.isKeyword("set", "generated") &&
+			!p.isKeyword("drop", "identity")
+		start := p.pos
+		if err := applyAlterColumn(p, col); err != nil {
+			return err
+		}
+
+		if isInherited {
+			end := p.pos
+			for _, d := range c.descendants(t) {
+				if inherited := d.column(name); inherited != nil {
+					p.pos = start
+					if err := applyAlterColumn(p, inherited); err != nil {
+						return err
+					}
+				}
+			}
+			p.pos = end
+		}
+	case p.acceptKeyword("inherit"):
+		schema, name, err := p.qualifiedName()
+		if err != nil {
+			return err
+		}
+
+		parent := c.table(c.qualify(schema, name))
+		if parent == nil {
+			return p.errorf("table %s to inherit from does not exist", c.qualify(schema, name))
+		}
+		for _, col := range parent.columns {
+			inherited := t.column(col.name)
+			if inherited == nil {
+				return p.errorf("child table %s is missing column %s", t.name, col.name)
+			}
+			inherited.inhCount++
+		}
+		t.parents = append(t.parents, parent.name)
+	case p.acceptKeyword("no", "inherit"):
+		schema, name, err := p.qualifiedName()
+		if err != nil {
+			return err
+		}
+
+		parent := c.table(c.qualify(schema, name))
+		if parent == nil || !slices.Contains(t.parents, parent.name) {
+			return p.errorf("table %s is not a parent of table %s", c.qualify(schema, name), t.name)
+		}
+		for _, col := range parent.columns {
+			if inherited := t.column(col.name); inherited != nil {
+				inherited.inhCount--
+				inherited.isLocal = true
+			}
+		}
+		t.parents = slices.DeleteFunc(t.parents, func(n qualifiedName) bool {
+			return n == parent.name
+		})
+	}
+
+	// other actions such as OWNER TO and ENABLE TRIGGER are ignored
+	return nil
+}
+
+// applyAlterColumn applies an action of ALTER TABLE ... ALTER COLUMN to the column.
+func applyAlterColumn(p *parser, col *column) error {
+	var err error
+	switch {
+	case p.acceptKeyword("set", "data", "type"), p.acceptKeyword("type"):
+		if col.typ, err = p.parseType(); err != nil {
+			return err
+		}
+	case p.acceptKeyword("set", "not", "null"):
+		col.isNullable = false
+	case p.acceptKeyword("drop", "not", "null"):
+		col.isNullable = true
+	case p.acceptKeyword("set", "default"):
+		col.defaultExpr = renderTokens(p.skipUntil(nil))
+	case p.acceptKeyword("drop", "default"):
+		col.defaultExpr = ""
+	case p.acceptKeyword("add", "generated"):
+		if err := parseGenerated(p, col); err != nil {
+			return err
+		}
+	case p.acceptKeyword("set", "generated"):
+		if col.identity, err = parseIdentityGeneration(p); err != nil {
+			return err
+		}
+	case p.acceptKeyword("drop", "identity"):
+		col.identity = ""
+	case p.acceptKeyword("drop", "expression"):
+		col.isGenerated = false
+	}
+
+	// other actions such as SET STATISTICS and SET STORAGE are ignored
+	return nil
+}
+
+func (c *catalog) applyAlterType(p *parser) error {
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	e := c.enum(c.qualify(schema, name))
+	if e == nil {
+		// types other than enums are not loaded
+		return nil
+	}
+
+	switch {
+	case p.acceptKeyword("add", "value"):
+		ifNotExists := p.acceptKeyword("if", "not", "exists")
+		value := p.next()
+		if value.kind != tokenString {
+			return p.errorf("expected string literal for enum label")
+		}
+		if slices.Contains(e.values, value.text) {
+			if ifNotExists {
+				return nil
+			}
+
+			return p.errorf("enum label %q already exists", value.text)
+		}
+
+		position := len(e.values)
+		switch {
+		case p.acceptKeyword("before"):
+			if position = slices.Index(e.values, p.next().text); position < 0 {
+				return p.errorf("enum label does not exist")
+			}
+		case p.acceptKeyword("after"):
+			if position = slices.Index(e.values, p.next().text); position < 0 {
+				return p.errorf("enum label does not exist")
+			}
+			position++
+		}
+		e.values = slices.Insert(e.values, position, value.text)
+	case p.acceptKeyword("rename", "value"):
+		oldValue := p.next()
+		if err := p.expectKeyword("to"); err != nil {
+			return err
+		}
+		newValue := p.next()
+		e.values = replaceString(e.values, oldValue.text, newValue.text)
+	case p.acceptKeyword("rename", "to"):
+		newName, err := p.ident()
+		if err != nil {
+			return err
+		}
+		c.renameEnum(e, newName)
+	}
+
+	return nil
+}
+
+// renameEnum renames the enum together with the types referencing it.
+func (c *catalog) renameEnum(e *enum, newName string) {
+	c.renameType(e.name, qualifiedName{schema: e.name.schema, name: newName})
+	e.name.name = newName
+}
+
+func (c *catalog) applyAlterIndex(p *parser) error {
+	ifExists := p.acceptKeyword("if", "exists")
+
+	schema, name, err := p.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	_, idx := c.index(c.qualify(schema, name))
+	if idx == nil {
+		if ifExists {
+			return nil
+		}
+
+		return p.errorf("index %s does not exist", c.qualify(schema, name))
+	}
+
+	if p.acceptKeyword("rename", "to") {
+		if idx.name, err = p.ident(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *catalog) applyDrop(p *parser) error {
+	var drop func(name qualifiedName) bool
+	switch {
+	case p.acceptKeyword("table"):
+		drop = func(name qualifiedName) bool {
+			if c.table(name) == nil {
+				return c.skipped[name]
+			}
+			c.dropTable(name)
+
+			return true
+		}
+	case p.acceptKeyword("index"):
+		p.acceptKeyword("concurrently")
+		drop = func(name qualifiedName) bool {
+			t, _ := c.index(name)
+			if t == nil {
+				return false
+			}
+			t.dropIndex(name.name)
+
+			return true
+		}
+	case p.acceptKeyword("type"):
+		drop = func(name qualifiedName) bool {
+			if c.enum(name) == nil {
+				return false
+			}
+			c.enums = slices.DeleteFunc(c.enums, func(e *enum) bool {
+				return e.name == name
+			})
+
+			return true
+		}
+	case p.acceptKeyword("domain"):
+		drop = func(name qualifiedName) bool {
+			if c.domain(name) == nil {
+				return false
+			}
+			c.domains = slices.DeleteFunc(c.domains, func(d *domain) bool {
+				return d.name == name
+			})
+
+			return true
+		}
+	default:
+		return nil
+	}
+
+	ifExists := p.acceptKeyword("if", "exists")
+	for {
+		schema, name, err := p.qualifiedName()
+		if err != nil {
+			return err
+		}
+
+		if !drop(c.qualify(schema, name)) && !ifExists {
+			return p.errorf("%s does not exist", c.qualify(schema, name))
+		}
+
+		if !p.acceptSymbol(",") {
+			return nil
+		}
+	}
+}
+
+func (c *catalog) applyComment(p *parser) error {
+	var set func(comment string) error
+	switch {
+	case p.acceptKeyword("table"):
+		schema, name, err := p.qualifiedName()
+		if err != nil {
+			return err
+		}
+		t, err := c.lookupTable(p, c.qualify(schema, name), false)
+		if err != nil || t == nil {
+			return err
+		}
+		set = func(comment string) error {
+			t.comment = comment
+			return nil
+		}
+	case p.acceptKeyword("column"):
+		// the name of a column is qualified by the table, i.e. [schema.]table.column
+		parts := make([]string, 0, 3)
+		for {
+			part, err := p.ident()
+			if err != nil {
+				return err
+			}
+			parts = append(parts, part)
+
+			if !p.acceptSymbol(".") {
+				break
+			}
+		}
+		if len(parts) < 2 {
+			return p.errorf("column name must be qualified by table name")
+		}
+
+		var schema string
+		if len(parts) > 2 {
+			schema = parts[len(parts)-3]
+		}
+		tableName := c.qualify(schema, parts[len(parts)-2])
+		t, err := c.lookupTable(p, tableName, false)
+		if err != nil || t == nil {
+			return err
+		}
+		col := t.column(parts[len(parts)-1])
+		if col == nil {
+			return p.errorf("column %s of table %s does not exist", parts[len(parts)-1], tableName)
+		}
+		set = func(comment string) error {
+			col.comment = comment
+			return nil
+		}
+	case p.acceptKeyword("type"):
+		schema, name, err := p.qualifiedName()
+		if err != nil {
+			return err
+		}
+		e := c.enum(c.qualify(schema, name))
+		set = func(comment string) error {
+			if e != nil {
+				e.comment = comment
+			}
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	if err := p.expectKeyword("is"); err != nil {
+		return err
+	}
+
+	switch t := p.next(); {
+	case t.kind == tokenString:
+		return set(t.text)
+	case t.isKeyword("null"):
+		return set("")
+	default:
+		return p.errorf("expected string literal or NULL")
+	}
+}
+
+// applySet applies SET search_path. The first schema of the path is used for unqualified names.
+func (c *catalog) applySet(p *parser) error {
+	p.acceptKeyword("set")
+	p.acceptKeyword("session")
+	p.acceptKeyword("local")
+	if !p.acceptKeyword("search_path") {
+		return nil
+	}
+	if !p.acceptKeyword("to") && !p.acceptSymbol("=") {
+		return nil
+	}
+
+	c.setSearchPath(p.next())
+
+	return nil
+}
+
+// applySelect applies SELECT pg_catalog.set_config('search_path', '...', false) emitted by pg_dump.
+func (c *catalog) applySelect(p *parser) error {
+	p.acceptKeyword("select")
+	if _, name, err := p.qualifiedName(); err != nil || name != "set_config" {
+		return nil
+	}
+
+	args, err := p.group()
+	if err != nil {
+		return nil
+	}
+
+	parts := splitByComma(args)
+	if len(parts) < 2 || len(parts[0]) != 1 || parts[0][0].text != "search_path" || len(parts[1]) != 1 {
+		return nil
+	}
+
+	c.setSearchPath(parts[1][0])
+
+	return nil
+}
+
+func (c *catalog) setSearchPath(t token) {
+	path := t.text
+	if t.kind == tokenString {
+		// a string literal may have a comma-separated list, e.g. 'app, public'
+		path, _, _ = strings.Cut(path, ",")
+		path = strings.Trim(strings.TrimSpace(path), `"`)
+	}
+
+	// an empty search path means every name is qualified as pg_dump does
+	if path == "" || t.isKeyword("default") {
+		path = defaultSchema
+	}
+
+	c.searchPath = path
+}
@@ -0,0 +1,380 @@
+package ddl
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/kmtym1998/chair/generator"
+)
+
+// SchemaLoader loads table schemas from PostgreSQL DDL files without connecting to a database.
+// CREATE TABLE, ALTER TABLE, CREATE INDEX, CREATE TYPE ... AS ENUM, CREATE DOMAIN, COMMENT ON and DROP statements are
+// applied in order, and the other statements are ignored. Columns of domains are loaded with the base type, and tables
+// inheriting from other tables get the inherited columns. Clauses which cannot be loaded, such as typed tables, fail
+// with an error. Views are not loaded since their column types cannot be determined.
+type SchemaLoader struct {
+	schemas []string
+	// sources returns the SQL to apply in order.
+	sources func() ([]source, error)
+	// catalog caches the parsed sources, which are shared by LoadTableSchemas and LoadEnums.
+	catalog *catalog
+}
+
+// source is SQL read from a file.
+type source struct {
+	path string
+	sql  string
+}
+
+// NewSchemaLoader returns a loader reading the files in order. Tables and enums in the schemas are loaded ("public" when
+// no schema is given), and unqualified names belong to "public" unless the search path is changed by SET search_path.
+func NewSchemaLoader(paths []string, schemas ...string) *SchemaLoader {
+	return newSchemaLoader(schemas, func() ([]source, error) {
+		sources := make([]source, len(paths))
+		for i, path := range paths {
+			src, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read DDL file: %w", err)
+			}
+
+			sources[i] = source{path: path, sql: string(src)}
+		}
+
+		return sources, nil
+	})
+}
+
+func newSchemaLoader(schemas []string, sources func() ([]source, error)) *SchemaLoader {
+	schemas = slices.DeleteFunc(slices.Clone(schemas), func(schema string) bool { return schema == "" })
+	if len(schemas) == 0 {
+		schemas = []string{defaultSchema}
+	}
+
+	return &SchemaLoader{
+		schemas: schemas,
+		sources: sources,
+	}
+}
+
+func (s *SchemaLoader) LoadTableSchemas(_ context.Context) ([]generator.Table, error) {
+	c, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []generator.Table
+	for _, schema := range s.schemas {
+		tables = append(tables, c.tableSchemas(schema)...)
+	}
+
+	return tables, nil
+}
+
+func (s *SchemaLoader) LoadEnums(_ context.Context) ([]generator.Enum, error) {
+	c, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	var enums []generator.Enum
+	for _, schema := range s.schemas {
+		enums = append(enums, c.enumSchemas(schema)...)
+	}
+
+	return enums, nil
+}
+
+func (s *SchemaLoader) Dialect() generator.Dialect {
+	return generator.DialectPostgres
+}
+
+func (s *SchemaLoader) load() (*catalog, error) {
+	if s.catalog != nil {
+		return s.catalog, nil
+	}
+
+	sources, err := s.sources()
+	if err != nil {
+		return nil, err
+	}
+
+	c := newCatalog()
+	for _, src := range sources {
+		slog.Debug("loading DDL file", "path", src.path)
+
+		if err := c.applySQL(src.sql); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", src.path, err)
+		}
+	}
+	s.catalog = c
+
+	return c, nil
+}
+
+// applySQL applies all the statements of the SQL to the catalog.
+func (c *catalog) applySQL(src string) error {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range splitStatements(tokens) {
+		if err := c.apply(stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tableSchemas converts the tables of the schema ordered by name, in the same form as postgres.SchemaLoader returns.
+func (c *catalog) tableSchemas(schema string) []generator.Table {
+	var tables []*table
+	for _, t := range c.tables {
+		if t.name.schema == schema {
+			tables = append(tables, t)
+		}
+	}
+	slices.SortFunc(tables, func(a, b *table) int {
+		return strings.Compare(a.name.name, b.name.name)
+	})
+
+	tableSchemas := make([]generator.Table, len(tables))
+	for i, t := range tables {
+		tableSchemas[i] = generator.Table{
+			Schema:      t.name.schema,
+			Name:        t.name.name,
+			Kind:        generator.TableKindTable,
+			Comment:     t.comment,
+			Columns:     c.columnSchemas(t),
+			ForeignKeys: c.foreignKeySchemas(t),
+		}
+
+		var constraintIndexes []generator.Index
+		if t.primaryKey != nil {
+			tableSchemas[i].PrimaryKey = t.primaryKey.columns
+			constraintIndexes = append(constraintIndexes, constraintIndex(t, t.primaryKey, true))
+		}
+
+		uniques := slices.Clone(t.uniques)
+		slices.SortFunc(uniques, func(a, b *constraint) int {
+			return strings.Compare(a.name, b.name)
+		})
+		for _, u := range uniques {
+			tableSchemas[i].UniqueConstraints = append(tableSchemas[i].UniqueConstraints, generator.UniqueConstraint{
+				Name:    u.name,
+				Columns: u.columns,
+			})
+			constraintIndexes = append(constraintIndexes, constraintIndex(t, u, false))
+		}
+
+		indexes := constraintIndexes
+		for _, idx := range t.indexes {
+			indexes = append(indexes, indexSchema(t, idx))
+		}
+		slices.SortFunc(indexes, func(a, b generator.Index) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		tableSchemas[i].Indexes = indexes
+	}
+
+	return tableSchemas
+}
+
+func (c *catalog) columnSchemas(t *table) []generator.Column {
+	columns := make([]generator.Column, len(t.columns))
+	for i, col := range t.columns {
+		typ, isDomainNotNull := c.baseType(col.typ)
+		columns[i] = generator.Column{
+			Name:    col.name,
+			Comment: col.comment,
+			// primary key columns and columns of a NOT NULL domain are NOT NULL regardless of the column definition
+			IsNullable: col.isNullable && !isDomainNotNull &&
+				(t.primaryKey == nil || !slices.Contains(t.primaryKey.columns, col.name)),
+			OrderAsc:           col.position,
+			Default:            col.defaultExpr,
+			IdentityGeneration: generator.IdentityGeneration(col.identity),
+			IsGenerated:        col.isGenerated,
+		}
+
+		dataType, enumName := c.dataType(typ)
+		if typ.arrayDims > 0 {
+			columns[i].Type = "ARRAY"
+			columns[i].ArrayDims = typ.arrayDims
+			columns[i].ElemType = dataType
+		} else {
+			columns[i].Type = dataType
+		}
+		columns[i].Enum = enumName.name
+		columns[i].EnumSchema = enumName.schema
+		typ.setSizes(&columns[i], dataType)
+	}
+
+	slices.SortStableFunc(columns, func(a, b generator.Column) int {
+		return a.OrderAsc - b.OrderAsc
+	})
+
+	return columns
+}
+
+// dataType returns data_type of information_schema.columns for the (element) type,
+// and the qualified name of the enum for enums, which is zero otherwise.
+func (c *catalog) dataType(typ typeRef) (string, qualifiedName) {
+	if dataType, ok := typ.builtinDataType(); ok {
+		return dataType, qualifiedName{}
+	}
+
+	if e := c.enum(c.qualify(typ.schema, typ.name)); e != nil {
+		return "USER-DEFINED", e.name
+	}
+
+	return "USER-DEFINED", qualifiedName{}
+}
+
+// foreignKeySchemas converts the foreign keys ordered by the position of their first column as postgres.SchemaLoader does.
+// The primary key of the referenced table is used when the referenced columns are omitted.
+func (c *catalog) foreignKeySchemas(t *table) []generator.ForeignKey {
+	if len(t.foreignKeys) == 0 {
+		return nil
+	}
+
+	position := func(fk *foreignKey) int {
+		minPosition := -1
+		for _, name := range fk.columns {
+			if col := t.column(name); col != nil && (minPosition < 0 || col.position < minPosition) {
+				minPosition = col.position
+			}
+		}
+
+		return minPosition
+	}
+
+	foreignKeys := slices.Clone(t.foreignKeys)
+	slices.SortStableFunc(foreignKeys, func(a, b *foreignKey) int {
+		return position(a) - position(b)
+	})
+
+	foreignKeySchemas := make([]generator.ForeignKey, 0, len(foreignKeys))
+	for _, fk := range foreignKeys {
+		refColumns := fk.refColumns
+		if len(refColumns) == 0 {
+			if ref := c.table(fk.refTable); ref != nil && ref.primaryKey != nil {
+				refColumns = ref.primaryKey.columns
+			}
+		}
+
+		foreignKeySchemas = append(foreignKeySchemas, generator.ForeignKey{
+			Name:                fk.name,
+			Columns:             fk.columns,
+			RefSchema:           fk.refTable.schema,
+			RefTable:            fk.refTable.name,
+			RefColumns:          refColumns,
+			OnDelete:            generator.ForeignKeyAction(fk.onDelete),
+			OnUpdate:            generator.ForeignKeyAction(fk.onUpdate),
+			IsDeferrable:        fk.isDeferrable,
+			IsInitiallyDeferred: fk.isInitiallyDeferred,
+		})
+	}
+
+	return foreignKeySchemas
+}
+
+// constraintIndex returns the index backing the primary key or unique constraint.
+func constraintIndex(t *table, con *constraint, isPrimary bool) generator.Index {
+	keys := make([]indexKey, len(con.columns))
+	for i, name := range con.columns {
+		keys[i] = indexKey{column: name, tokens: []token{identToken(name, 0)}}
+	}
+
+	idx := indexSchema(t, &index{
+		name:     con.name,
+		isUnique: true,
+		method:   "btree",
+		keys:     keys,
+	})
+	idx.IsPrimary = isPrimary
+
+	return idx
+}
+
+func indexSchema(t *table, idx *index) generator.Index {
+	schema := generator.Index{
+		Name:      idx.name,
+		IsUnique:  idx.isUnique,
+		IsPartial: len(idx.where) > 0,
+	}
+
+	keys := make([]string, len(idx.keys))
+	for i, key := range idx.keys {
+		if key.column != "" {
+			schema.Columns = append(schema.Columns, key.column)
+		} else {
+			schema.HasExpression = true
+		}
+		keys[i] = renderTokens(key.tokens)
+	}
+
+	// the definition follows the format of pg_get_indexdef
+	var b strings.Builder
+	b.WriteString("CREATE ")
+	if idx.isUnique {
+		b.WriteString("UNIQUE ")
+	}
+	fmt.Fprintf(&b, "INDEX %s ON %s USING %s (%s)", quoteIdent(idx.name), t.name, idx.method, strings.Join(keys, ", "))
+	if len(idx.where) > 0 {
+		where := renderTokens(idx.where)
+		if !isParenthesized(idx.where) {
+			where = "(" + where + ")"
+		}
+		b.WriteString(" WHERE " + where)
+	}
+	schema.Definition = b.String()
+
+	return schema
+}
+
+// isParenthesized reports whether the whole tokens are enclosed by a pair of parentheses.
+func isParenthesized(tokens []token) bool {
+	if len(tokens) < 2 || !tokens[0].isSymbol("(") {
+		return false
+	}
+
+	depth := 0
+	for i, t := range tokens {
+		switch {
+		case t.isSymbol("("):
+			depth++
+		case t.isSymbol(")"):
+			depth--
+			if depth == 0 {
+				return i == len(tokens)-1
+			}
+		}
+	}
+
+	return false
+}
+
+// enumSchemas converts the enums of the schema ordered by name.
+func (c *catalog) enumSchemas(schema string) []generator.Enum {
+	var enums []generator.Enum
+	for _, e := range c.enums {
+		if e.name.schema == schema {
+			enums = append(enums, generator.Enum{
+				Schema:  e.name.schema,
+				Name:    e.name.name,
+				Comment: e.comment,
+				Values:  e.values,
+			})
+		}
+	}
+	slices.SortFunc(enums, func(a, b generator.Enum) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return enums
+}
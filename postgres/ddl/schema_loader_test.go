@@ -0,0 +1,198 @@
+package ddl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kmtym1998/chair/generator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTableSchemas(t *testing.T) {
+	ldr := NewSchemaLoader([]string{"testdata/schema.sql", "testdata/migration.sql"}, "")
+
+	actual, err := ldr.LoadTableSchemas(context.Background())
+	if err != nil {
+		t.Fatalf("failed to load table schemas: %v", err)
+	}
+
+	expected := []generator.Table{
+		{
+			Schema: "public",
+			Name:   "archived_books",
+			Kind:   generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "bigint", IsNullable: false, OrderAsc: 1},
+				{Name: "author_id", Type: "bigint", IsNullable: false, OrderAsc: 2},
+				{Name: "title", Type: "character varying", IsNullable: false, OrderAsc: 3, Length: 100},
+				{Name: "price", Type: "numeric", IsNullable: false, OrderAsc: 4, Precision: 10, Scale: 2},
+				{Name: "published", Type: "boolean", IsNullable: false, OrderAsc: 5, Default: "false"},
+				{Name: "archived_at", Type: "timestamp with time zone", IsNullable: false, OrderAsc: 6, DatetimePrecision: 6},
+				{Name: "archived_by", Type: "character varying", IsNullable: true, OrderAsc: 7, Length: 320},
+			},
+		},
+		{
+			Schema: "public",
+			Name:   "author_profiles",
+			Kind:   generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('author_profiles_id_seq'::regclass)"},
+				{Name: "author_id", Type: "bigint", IsNullable: false, OrderAsc: 2},
+				{Name: "biography", Type: "text", IsNullable: true, OrderAsc: 3},
+				{Name: "website", Type: "character varying", IsNullable: true, OrderAsc: 5, Length: 255},
+			},
+			PrimaryKey: []string{"id"},
+			UniqueConstraints: []generator.UniqueConstraint{
+				{Name: "author_profiles_author_id_key", Columns: []string{"author_id"}},
+			},
+			Indexes: []generator.Index{
+				{Name: "author_profiles_author_id_key", Columns: []string{"author_id"}, IsUnique: true, Definition: "CREATE UNIQUE INDEX author_profiles_author_id_key ON public.author_profiles USING btree (author_id)"},
+				{Name: "author_profiles_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX author_profiles_pkey ON public.author_profiles USING btree (id)"},
+				{Name: "author_profiles_website_idx", Columns: []string{"website"}, Definition: "CREATE INDEX author_profiles_website_idx ON public.author_profiles USING btree (website DESC NULLS LAST)"},
+			},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "author_profiles_author_id_fkey", Columns: []string{"author_id"}, RefSchema: "public", RefTable: "authors", RefColumns: []string{"id"}, OnDelete: generator.ForeignKeyActionSetNull, OnUpdate: generator.ForeignKeyActionNoAction},
+			},
+		},
+		{
+			Schema:  "public",
+			Name:    "authors",
+			Kind:    generator.TableKindTable,
+			Comment: "authors of books",
+			Columns: []generator.Column{
+				{Name: "id", Type: "bigint", IsNullable: false, OrderAsc: 1, Default: "nextval('public.authors_id_seq'::regclass)"},
+				{Name: "name", Type: "character varying", IsNullable: false, OrderAsc: 2, Comment: "display name", Length: 255},
+				{Name: "email", Type: "text", IsNullable: true, OrderAsc: 3},
+				{Name: "mood", Type: "USER-DEFINED", IsNullable: false, OrderAsc: 4, Enum: "mood", EnumSchema: "public", Default: "'ok'::public.mood"},
+				{Name: "tags", Type: "ARRAY", IsNullable: true, OrderAsc: 5, ArrayDims: 1, ElemType: "text"},
+				{Name: "scores", Type: "ARRAY", IsNullable: false, OrderAsc: 6, ArrayDims: 2, ElemType: "integer"},
+				{Name: "created_at", Type: "timestamp with time zone", IsNullable: false, OrderAsc: 7, Default: "now()", DatetimePrecision: 6},
+				{Name: "updated_at", Type: "timestamp without time zone", IsNullable: true, OrderAsc: 8, DatetimePrecision: 6},
+			},
+			PrimaryKey: []string{"id"},
+			UniqueConstraints: []generator.UniqueConstraint{
+				{Name: "authors_email_key", Columns: []string{"email"}},
+			},
+			Indexes: []generator.Index{
+				{Name: "authors_email_key", Columns: []string{"email"}, IsUnique: true, Definition: "CREATE UNIQUE INDEX authors_email_key ON public.authors USING btree (email)"},
+				{Name: "authors_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX authors_pkey ON public.authors USING btree (id)"},
+			},
+		},
+		{
+			Schema: "public",
+			Name:   "books",
+			Kind:   generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "bigint", IsNullable: false, OrderAsc: 1},
+				{Name: "author_id", Type: "bigint", IsNullable: false, OrderAsc: 2},
+				{Name: "title", Type: "character varying", IsNullable: false, OrderAsc: 3, Length: 100},
+				{Name: "price", Type: "numeric", IsNullable: false, OrderAsc: 4, Precision: 10, Scale: 2},
+				{Name: "published", Type: "boolean", IsNullable: false, OrderAsc: 5, Default: "false"},
+			},
+			PrimaryKey: []string{"id"},
+			Indexes: []generator.Index{
+				{Name: "books_author_id_idx", Columns: []string{"author_id"}, Definition: "CREATE INDEX books_author_id_idx ON public.books USING btree (author_id)"},
+				{Name: "books_lower_title_idx", IsUnique: true, IsPartial: true, HasExpression: true, Definition: "CREATE UNIQUE INDEX books_lower_title_idx ON public.books USING btree (lower(title)) WHERE (published)"},
+				{Name: "books_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX books_pkey ON public.books USING btree (id)"},
+			},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "books_author_id_fkey", Columns: []string{"author_id"}, RefSchema: "public", RefTable: "authors", RefColumns: []string{"id"}, OnDelete: generator.ForeignKeyActionCascade, OnUpdate: generator.ForeignKeyActionNoAction, IsDeferrable: true, IsInitiallyDeferred: true},
+			},
+		},
+		{
+			Schema: "public",
+			Name:   "line_items",
+			Kind:   generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "bigint", IsNullable: false, OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationByDefault},
+				{Name: "quantity", Type: "integer", IsNullable: false, OrderAsc: 2},
+				{Name: "unit_price", Type: "numeric", IsNullable: false, OrderAsc: 3, Precision: 10, Scale: 2},
+				{Name: "total", Type: "numeric", IsNullable: true, OrderAsc: 4, IsGenerated: true, Precision: 12, Scale: 2},
+				{Name: "note", Type: "text", IsNullable: true, OrderAsc: 5, Default: "''"},
+				{Name: "discount", Type: "numeric", IsNullable: false, OrderAsc: 6, Precision: 10, Scale: 2},
+			},
+			PrimaryKey: []string{"id"},
+			Indexes: []generator.Index{
+				{Name: "line_items_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX line_items_pkey ON public.line_items USING btree (id)"},
+			},
+		},
+	}
+
+	t.Run("assert table schemas", func(t *testing.T) {
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("assert enums", func(t *testing.T) {
+		enums, err := ldr.LoadEnums(context.Background())
+		if err != nil {
+			t.Fatalf("failed to load enums: %v", err)
+		}
+
+		assert.Equal(t, []generator.Enum{
+			{Schema: "public", Name: "mood", Comment: "mood of a person", Values: []string{"angry", "sad", "ok", "happy"}},
+		}, enums)
+	})
+
+	t.Run("assert other schema", func(t *testing.T) {
+		tables, err := NewSchemaLoader([]string{"testdata/schema.sql"}, "audit").LoadTableSchemas(context.Background())
+		if err != nil {
+			t.Fatalf("failed to load table schemas: %v", err)
+		}
+
+		assert.Len(t, tables, 1)
+		assert.Equal(t, "events", tables[0].Name)
+		assert.Equal(t, []generator.ForeignKey{
+			{Name: "events_author_id_fkey", Columns: []string{"author_id"}, RefSchema: "public", RefTable: "authors", RefColumns: []string{"id"}, OnDelete: generator.ForeignKeyActionNoAction, OnUpdate: generator.ForeignKeyActionCascade},
+		}, tables[0].ForeignKeys)
+		// the enum is in the schema of the type, not of the table
+		assert.Equal(t, generator.Column{Name: "mood", Type: "USER-DEFINED", IsNullable: true, OrderAsc: 3, Enum: "mood", EnumSchema: "public"}, tables[0].Columns[2])
+	})
+
+	t.Run("assert multiple schemas", func(t *testing.T) {
+		tables, err := NewSchemaLoader([]string{"testdata/schema.sql"}, "audit", "public").LoadTableSchemas(context.Background())
+		if err != nil {
+			t.Fatalf("failed to load table schemas: %v", err)
+		}
+
+		names := make([]string, len(tables))
+		for i, table := range tables {
+			names[i] = table.Schema + "." + table.Name
+		}
+		assert.Equal(t, []string{"audit.events", "public.archived_books", "public.authors", "public.books"}, names)
+	})
+}
+
+func TestLoadTableSchemas_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		sql     string
+		wantErr string
+	}{
+		{"unknown table", "ALTER TABLE users ADD COLUMN name text;", "line 1: table public.users does not exist"},
+		{"duplicated table", "CREATE TABLE users (id int);\nCREATE TABLE users (id int);", "line 2: table public.users already exists"},
+		{"syntax error", "CREATE TABLE users (id int NOT);", "line 1: unexpected token in column definition"},
+		{"unterminated string", "COMMENT ON TABLE users IS 'users;", "line 1: unterminated string literal"},
+		{"unterminated copy data", "COPY users (id) FROM stdin;\n1\n", `line 1: missing \. terminating COPY data`},
+		{"unknown parent", "CREATE TABLE users (id int) INHERITS (people);", "line 1: table public.people to inherit from does not exist"},
+		{"typed table", "CREATE TYPE person AS (name text);\nCREATE TABLE people OF person;", "line 2: typed table public.people is not supported"},
+		{"unsupported table clause", "CREATE TABLE users (id int) SERVER remote;", "line 1: unsupported clause in CREATE TABLE"},
+		{"schema elements", "CREATE SCHEMA app CREATE TABLE users (id int);", "line 1: schema elements of CREATE SCHEMA are not supported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "schema.sql")
+			if err := os.WriteFile(path, []byte(tt.sql), 0o600); err != nil {
+				t.Fatalf("failed to write DDL file: %v", err)
+			}
+
+			_, err := NewSchemaLoader([]string{path}, "").LoadTableSchemas(context.Background())
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+CREATE TABLE author_profiles (
+    id serial PRIMARY KEY,
+    author_id bigint NOT NULL UNIQUE REFERENCES authors ON DELETE SET NULL,
+    "Bio" text,
+    removed text
+);
+
+ALTER TABLE author_profiles DROP COLUMN removed, ADD COLUMN website varchar(255);
+ALTER TABLE author_profiles RENAME COLUMN "Bio" TO biography;
+ALTER TABLE books ALTER COLUMN price SET NOT NULL, ALTER COLUMN title TYPE varchar(100);
+ALTER TYPE mood ADD VALUE 'angry' BEFORE 'sad';
+CREATE INDEX ON author_profiles (website DESC NULLS LAST);
+DROP INDEX IF EXISTS no_such_index;
+CREATE TABLE line_items (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    quantity integer NOT NULL DEFAULT 1,
+    unit_price numeric(10,2) NOT NULL,
+    total numeric(12,2) GENERATED ALWAYS AS (quantity * unit_price) STORED,
+    note text
+);
+
+ALTER TABLE line_items ALTER COLUMN id SET GENERATED BY DEFAULT, ALTER COLUMN note SET DEFAULT '',
+    ALTER COLUMN quantity DROP DEFAULT;
+
+CREATE DOMAIN positive_price AS numeric(10,2) NOT NULL CHECK (VALUE > 0);
+ALTER TABLE line_items ADD COLUMN discount positive_price;
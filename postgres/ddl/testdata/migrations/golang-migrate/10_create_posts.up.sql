@@ -0,0 +1,6 @@
+CREATE TABLE posts (
+    id bigserial PRIMARY KEY,
+    user_id bigint NOT NULL REFERENCES users (id),
+    body text NOT NULL
+);
+ALTER TABLE users DROP COLUMN nickname;
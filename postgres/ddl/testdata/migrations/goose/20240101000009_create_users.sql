@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE users (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL,
+    nickname text
+);
+
+-- +goose Down
+DROP TABLE users;
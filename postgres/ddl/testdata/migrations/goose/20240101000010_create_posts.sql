@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE posts (
+    id bigserial PRIMARY KEY,
+    user_id bigint NOT NULL REFERENCES users (id),
+    body text NOT NULL
+);
+-- +goose StatementEnd
+ALTER TABLE users DROP COLUMN nickname;
+
+-- +goose Down
+ALTER TABLE users ADD COLUMN nickname text;
+DROP TABLE posts;
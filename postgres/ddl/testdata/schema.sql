@@ -0,0 +1,116 @@
+--
+-- PostgreSQL database dump
+--
+
+\restrict 3jJ1g3m0sXkF2cQ
+
+SET statement_timeout = 0;
+SET client_encoding = 'UTF8';
+SELECT pg_catalog.set_config('search_path', '', false);
+SET default_tablespace = '';
+
+CREATE EXTENSION IF NOT EXISTS pgcrypto WITH SCHEMA public;
+
+--
+-- Name: mood; Type: TYPE; Schema: public; Owner: test
+--
+
+CREATE TYPE public.mood AS ENUM (
+    'sad',
+    'ok',
+    'happy'
+);
+
+ALTER TYPE public.mood OWNER TO test;
+
+COMMENT ON TYPE public.mood IS 'mood of a person';
+
+CREATE DOMAIN public.email_address AS character varying(320)
+	CONSTRAINT email_address_check CHECK (((VALUE)::text ~~ '%@%'::text));
+
+CREATE FUNCTION public.touch_updated_at() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    NEW.updated_at := now();
+    RETURN NEW;
+END;
+$$;
+
+/* authors /* nested */ comment */
+CREATE TABLE public.authors (
+    id bigint NOT NULL,
+    name character varying(255) NOT NULL,
+    email text,
+    mood public.mood DEFAULT 'ok'::public.mood NOT NULL,
+    tags text[],
+    scores integer[][] NOT NULL,
+    created_at timestamp(6) with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp without time zone
+);
+
+COMMENT ON TABLE public.authors IS 'authors of books';
+COMMENT ON COLUMN public.authors.name IS 'display name';
+
+CREATE SEQUENCE public.authors_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE public.authors_id_seq OWNED BY public.authors.id;
+
+CREATE TABLE public.books (
+    id bigint NOT NULL,
+    author_id bigint NOT NULL,
+    title text NOT NULL,
+    price numeric(10,2),
+    published boolean DEFAULT false NOT NULL,
+    CONSTRAINT books_price_check CHECK ((price > (0)::numeric))
+);
+
+CREATE TABLE public.archived_books (
+    title text NOT NULL,
+    archived_at timestamp with time zone NOT NULL,
+    archived_by public.email_address
+)
+INHERITS (public.books);
+
+COPY public.books (id, author_id, title, price, published) FROM stdin;
+1	1	It's; not a statement	10.00	t
+\.
+
+CREATE VIEW public.active_authors AS
+ SELECT authors.id,
+    authors.name
+   FROM public.authors
+  WHERE (authors.email IS NOT NULL);
+
+COMMENT ON VIEW public.active_authors IS 'authors with email';
+
+ALTER TABLE ONLY public.authors ALTER COLUMN id SET DEFAULT nextval('public.authors_id_seq'::regclass);
+
+ALTER TABLE ONLY public.authors
+    ADD CONSTRAINT authors_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY public.authors
+    ADD CONSTRAINT authors_email_key UNIQUE (email);
+
+ALTER TABLE ONLY public.books
+    ADD CONSTRAINT books_pkey PRIMARY KEY (id);
+
+CREATE INDEX books_author_id_idx ON public.books USING btree (author_id);
+
+CREATE UNIQUE INDEX books_lower_title_idx ON public.books USING btree (lower(title)) WHERE published;
+
+ALTER TABLE ONLY public.books
+    ADD CONSTRAINT books_author_id_fkey FOREIGN KEY (author_id) REFERENCES public.authors(id) ON DELETE CASCADE DEFERRABLE INITIALLY DEFERRED;
+
+CREATE TABLE audit.events (
+    id bigint NOT NULL,
+    author_id bigint REFERENCES public.authors (id) ON UPDATE CASCADE,
+    mood public.mood
+);
+
+\unrestrict 3jJ1g3m0sXkF2cQ
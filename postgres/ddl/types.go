@@ -0,0 +1,183 @@
+package ddl
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/kmtym1998/chair/generator"
+)
+
+// typeRef is a column type as written in DDL.
+type typeRef struct {
+	// schema is empty when the type is not qualified.
+	schema string
+	// name is the type name with multiple words joined by a space, e.g. "double precision".
+	name string
+	// modifiers are the tokens inside the parentheses, e.g. 10, 2 of numeric(10, 2).
+	modifiers []token
+	arrayDims int
+}
+
+// typeAliases maps type names and their aliases to data_type of information_schema.columns.
+// https://www.postgresql.org/docs/current/datatype.html#DATATYPE-TABLE
+var typeAliases = map[string]string{
+	"int":                         "integer",
+	"int4":                        "integer",
+	"integer":                     "integer",
+	"serial":                      "integer",
+	"serial4":                     "integer",
+	"int2":                        "smallint",
+	"smallint":                    "smallint",
+	"smallserial":                 "smallint",
+	"serial2":                     "smallint",
+	"int8":                        "bigint",
+	"bigint":                      "bigint",
+	"bigserial":                   "bigint",
+	"serial8":                     "bigint",
+	"float4":                      "real",
+	"real":                        "real",
+	"float8":                      "double precision",
+	"float":                       "double precision",
+	"double precision":            "double precision",
+	"decimal":                     "numeric",
+	"numeric":                     "numeric",
+	"bool":                        "boolean",
+	"boolean":                     "boolean",
+	"varchar":                     "character varying",
+	"character varying":           "character varying",
+	"char varying":                "character varying",
+	"char":                        "character",
+	"character":                   "character",
+	"bpchar":                      "character",
+	"text":                        "text",
+	"timestamp":                   "timestamp without time zone",
+	"timestamp without time zone": "timestamp without time zone",
+	"timestamptz":                 "timestamp with time zone",
+	"timestamp with time zone":    "timestamp with time zone",
+	"time":                        "time without time zone",
+	"time without time zone":      "time without time zone",
+	"timetz":                      "time with time zone",
+	"time with time zone":         "time with time zone",
+	"date":                        "date",
+	"interval":                    "interval",
+	"bit":                         "bit",
+	"varbit":                      "bit varying",
+	"bit varying":                 "bit varying",
+	"uuid":                        "uuid",
+	"json":                        "json",
+	"jsonb":                       "jsonb",
+	"jsonpath":                    "jsonpath",
+	"xml":                         "xml",
+	"bytea":                       "bytea",
+	"money":                       "money",
+	"inet":                        "inet",
+	"cidr":                        "cidr",
+	"macaddr":                     "macaddr",
+	"macaddr8":                    "macaddr8",
+	"point":                       "point",
+	"line":                        "line",
+	"lseg":                        "lseg",
+	"box":                         "box",
+	"path":                        "path",
+	"polygon":                     "polygon",
+	"circle":                      "circle",
+	"tsvector":                    "tsvector",
+	"tsquery":                     "tsquery",
+	"pg_lsn":                      "pg_lsn",
+	"pg_snapshot":                 "pg_snapshot",
+	"txid_snapshot":               "txid_snapshot",
+	"oid":                         "oid",
+	"name":                        "name",
+	"int4range":                   "int4range",
+	"int8range":                   "int8range",
+	"numrange":                    "numrange",
+	"tsrange":                     "tsrange",
+	"tstzrange":                   "tstzrange",
+	"daterange":                   "daterange",
+	"int4multirange":              "int4multirange",
+	"int8multirange":              "int8multirange",
+	"nummultirange":               "nummultirange",
+	"tsmultirange":                "tsmultirange",
+	"tstzmultirange":              "tstzmultirange",
+	"datemultirange":              "datemultirange",
+}
+
+// serialTypes imply NOT NULL and a sequence default.
+var serialTypes = map[string]bool{
+	"serial":      true,
+	"serial4":     true,
+	"smallserial": true,
+	"serial2":     true,
+	"bigserial":   true,
+	"serial8":     true,
+}
+
+// isSerial reports whether the type is one of the serial pseudo-types.
+func (t typeRef) isSerial() bool {
+	return (t.schema == "" || t.schema == "pg_catalog") && serialTypes[t.name]
+}
+
+// builtinDataType returns data_type of information_schema.columns for a built-in type.
+// The second return value is false for user-defined types.
+func (t typeRef) builtinDataType() (string, bool) {
+	if t.schema != "" && t.schema != "pg_catalog" {
+		return "", false
+	}
+
+	name := t.name
+	// interval may have fields, e.g. "interval day to second"
+	if strings.HasPrefix(name, "interval ") {
+		name = "interval"
+	}
+
+	dataType, ok := typeAliases[name]
+	if !ok {
+		return "", false
+	}
+
+	// float(p) is real for p <= 24
+	if name == "float" && len(t.modifiers) == 1 {
+		if p, err := strconv.Atoi(t.modifiers[0].text); err == nil && p <= 24 {
+			dataType = "real"
+		}
+	}
+
+	return dataType, true
+}
+
+// modifier returns the i-th modifier as a number. The second return value is false when it is missing.
+func (t typeRef) modifier(i int) (int, bool) {
+	if i >= len(t.modifiers) {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(t.modifiers[i].text)
+	return n, err == nil
+}
+
+// setSizes sets the sizes of the column of the data type from the modifiers as information_schema.columns reports them:
+// character and bit default to a length of 1, and time, timestamp and interval to a precision of 6.
+// Arrays have no sizes.
+func (t typeRef) setSizes(column *generator.Column, dataType string) {
+	if t.arrayDims > 0 {
+		return
+	}
+
+	switch dataType {
+	case "character", "bit":
+		column.Length = 1
+		if n, ok := t.modifier(0); ok {
+			column.Length = n
+		}
+	case "character varying", "bit varying":
+		column.Length, _ = t.modifier(0)
+	case "numeric":
+		column.Precision, _ = t.modifier(0)
+		column.Scale, _ = t.modifier(1)
+	case "time without time zone", "time with time zone", "timestamp without time zone", "timestamp with time zone", "interval":
+		column.DatetimePrecision = 6
+		if n, ok := t.modifier(0); ok {
+			column.DatetimePrecision = n
+		}
+	}
+}
@@ -317,6 +317,555 @@ func DefaultMappers() []config.TypeMapping {
 		},
 	}
 
+	// https://www.postgresql.org/docs/current/datatype-binary.html
+	// nil represents NULL
+	binaryTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "bytea",
+			GoType:     "[]byte",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "bytea",
+			GoType:     "[]byte",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/datatype-datetime.html#DATATYPE-INTERVAL-INPUT
+	// time.Duration cannot hold months and days, so interval is read as text, e.g. "1 year 2 mons 03:04:05"
+	intervalTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "interval",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "interval",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/datatype-json.html
+	// database/sql cannot scan NULL into json.RawMessage, so nullable columns are mapped to the pointer of it
+	jsonTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "json",
+			GoType:     "RawMessage",
+			GoPkg:      "encoding/json",
+			IsNullable: false,
+		},
+		{
+			DBType:     "jsonb",
+			GoType:     "RawMessage",
+			GoPkg:      "encoding/json",
+			IsNullable: false,
+		},
+		{
+			DBType:     "jsonpath",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "json",
+			GoType:     "*RawMessage",
+			GoPkg:      "encoding/json",
+			IsNullable: true,
+		},
+		{
+			DBType:     "jsonb",
+			GoType:     "*RawMessage",
+			GoPkg:      "encoding/json",
+			IsNullable: true,
+		},
+		{
+			DBType:     "jsonpath",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/datatype-net-types.html
+	networkTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "inet",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "cidr",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "macaddr",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "macaddr8",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "inet",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "cidr",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "macaddr",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "macaddr8",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/datatype-bit.html
+	// bit strings are read as text of 0 and 1, e.g. "101"
+	bitStringTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "bit",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "bit varying",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "bit",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "bit varying",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/datatype-xml.html
+	xmlTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "xml",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "xml",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/datatype-textsearch.html
+	textSearchTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "tsvector",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "tsquery",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "tsvector",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "tsquery",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/datatype-geometric.html
+	// geometric types are read as text, e.g. "(1,2)" for point
+	geometricTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "point",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "line",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "lseg",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "box",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "path",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "polygon",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "circle",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "point",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "line",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "lseg",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "box",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "path",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "polygon",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "circle",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/rangetypes.html
+	// ranges are read as text, e.g. "[1,10)" for int4range
+	rangeTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "int4range",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "int8range",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "numrange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "tsrange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "tstzrange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "daterange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "int4multirange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "int8multirange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "nummultirange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "tsmultirange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "tstzmultirange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "datemultirange",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "int4range",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "int8range",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "numrange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "tsrange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "tstzrange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "daterange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "int4multirange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "int8multirange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "nummultirange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "tsmultirange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "tstzmultirange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "datemultirange",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/datatype-oid.html
+	objectIdentifierTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "oid",
+			GoType:     "uint32",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "oid",
+			GoType:     "Null[uint32]",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/datatype-pg-lsn.html
+	pgLSNTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "pg_lsn",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "pg_lsn",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.postgresql.org/docs/current/arrays.html
+	// nil arrays represent NULL so that nullable array columns share the same mappings.
+	// pq arrays support one dimension only. database/sql cannot scan into slices, so multidimensional arrays and arrays
+	// of the other element types are unmapped and need mappings of the config.
+	arrayTypes := []config.TypeMapping{
+		{
+			DBType:    "smallint",
+			GoType:    "Int32Array",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "integer",
+			GoType:    "Int32Array",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "bigint",
+			GoType:    "Int64Array",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "numeric",
+			GoType:    "Float64Array",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "real",
+			GoType:    "Float32Array",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "double precision",
+			GoType:    "Float64Array",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "character",
+			GoType:    "StringArray",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "character varying",
+			GoType:    "StringArray",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "text",
+			GoType:    "StringArray",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "boolean",
+			GoType:    "BoolArray",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "bytea",
+			GoType:    "ByteaArray",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+		{
+			DBType:    "uuid",
+			GoType:    "StringArray",
+			GoPkg:     "github.com/lib/pq",
+			IsArray:   true,
+			ArrayDims: 1,
+		},
+	}
+
 	merge := func(arrList ...[]config.TypeMapping) []config.TypeMapping {
 		var itemsCount int
 		for _, arr := range arrList {
@@ -338,5 +887,17 @@ func DefaultMappers() []config.TypeMapping {
 		datetimeTypes,
 		booleanTypes,
 		uuidTypes,
+		binaryTypes,
+		intervalTypes,
+		jsonTypes,
+		networkTypes,
+		bitStringTypes,
+		xmlTypes,
+		textSearchTypes,
+		geometricTypes,
+		rangeTypes,
+		objectIdentifierTypes,
+		pgLSNTypes,
+		arrayTypes,
 	)
 }
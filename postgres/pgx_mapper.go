@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"github.com/kmtym1998/chair/generator/config"
+)
+
+const pgtypePkg = "github.com/jackc/pgx/v5/pgtype"
+
+// Mappers returns the default mappings of the profile.
+func Mappers(profile string) []config.TypeMapping {
+	if profile == config.MappingProfilePgx {
+		return PgxMappers()
+	}
+
+	return DefaultMappers()
+}
+
+// PgxMappers maps the PostgreSQL built-in types to the types of github.com/jackc/pgx/v5, keeping the precision of
+// numeric, money, interval, uuid etc. Nullable columns use the pgtype types with Valid, e.g. pgtype.Int4 for integer,
+// and the Go types which pgx scans NULL into as the zero value are shared, e.g. []byte for bytea and netip.Prefix
+// for inet since pgx v5 has no pgtype.Inet. Arrays are mapped to slices of the element type, which pgx scans natively.
+func PgxMappers() []config.TypeMapping {
+	// pgxMappings returns the mappings of the database types for non-nullable and nullable columns.
+	pgxMappings := func(dbTypes []string, goPkg, goType, nullableGoPkg, nullableGoType string) []config.TypeMapping {
+		mappings := make([]config.TypeMapping, 0, len(dbTypes)*2)
+		for _, dbType := range dbTypes {
+			mappings = append(mappings,
+				config.TypeMapping{DBType: dbType, GoType: goType, GoPkg: goPkg, IsNullable: false},
+				config.TypeMapping{DBType: dbType, GoType: nullableGoType, GoPkg: nullableGoPkg, IsNullable: true},
+			)
+		}
+
+		return mappings
+	}
+	// pgtypeMappings returns the mappings of the database types to the pgtype type regardless of nullability.
+	pgtypeMappings := func(dbTypes []string, goType string) []config.TypeMapping {
+		return pgxMappings(dbTypes, pgtypePkg, goType, pgtypePkg, goType)
+	}
+
+	var mappings []config.TypeMapping
+
+	// https://www.postgresql.org/docs/current/datatype-numeric.html
+	mappings = append(mappings, pgxMappings([]string{"smallint", "smallserial"}, "", "int16", pgtypePkg, "Int2")...)
+	mappings = append(mappings, pgxMappings([]string{"integer", "serial"}, "", "int32", pgtypePkg, "Int4")...)
+	mappings = append(mappings, pgxMappings([]string{"bigint", "bigserial"}, "", "int64", pgtypePkg, "Int8")...)
+	mappings = append(mappings, pgxMappings([]string{"real"}, "", "float32", pgtypePkg, "Float4")...)
+	mappings = append(mappings, pgxMappings([]string{"double precision"}, "", "float64", pgtypePkg, "Float8")...)
+	mappings = append(mappings, pgtypeMappings([]string{"numeric", "decimal"}, "Numeric")...)
+
+	// https://www.postgresql.org/docs/current/datatype-money.html
+	// money is formatted by lc_monetary, so it is read as text without losing precision
+	mappings = append(mappings, pgxMappings([]string{"money"}, "", "string", pgtypePkg, "Text")...)
+
+	// https://www.postgresql.org/docs/current/datatype-character.html
+	mappings = append(mappings, pgxMappings([]string{"character varying", "character", "text", "name", `"char"`}, "", "string", pgtypePkg, "Text")...)
+
+	// https://www.postgresql.org/docs/current/datatype-binary.html
+	mappings = append(mappings, pgxMappings([]string{"bytea"}, "", "[]byte", "", "[]byte")...)
+
+	// https://www.postgresql.org/docs/current/datatype-datetime.html
+	mappings = append(mappings, pgtypeMappings([]string{"date"}, "Date")...)
+	mappings = append(mappings, pgtypeMappings([]string{"timestamp without time zone"}, "Timestamp")...)
+	mappings = append(mappings, pgtypeMappings([]string{"timestamp with time zone"}, "Timestamptz")...)
+	mappings = append(mappings, pgtypeMappings([]string{"time without time zone"}, "Time")...)
+	mappings = append(mappings, pgtypeMappings([]string{"interval"}, "Interval")...)
+	// pgx has no type for time with time zone
+	mappings = append(mappings, pgxMappings([]string{"time with time zone"}, "", "string", pgtypePkg, "Text")...)
+
+	// https://www.postgresql.org/docs/current/datatype-boolean.html
+	mappings = append(mappings, pgxMappings([]string{"boolean"}, "", "bool", pgtypePkg, "Bool")...)
+
+	// https://www.postgresql.org/docs/current/datatype-uuid.html
+	mappings = append(mappings, pgtypeMappings([]string{"uuid"}, "UUID")...)
+
+	// https://www.postgresql.org/docs/current/datatype-json.html
+	mappings = append(mappings, pgxMappings([]string{"json", "jsonb"}, "", "[]byte", "", "[]byte")...)
+	mappings = append(mappings, pgxMappings([]string{"jsonpath"}, "", "string", pgtypePkg, "Text")...)
+
+	// https://www.postgresql.org/docs/current/datatype-xml.html
+	mappings = append(mappings, pgxMappings([]string{"xml"}, "", "string", pgtypePkg, "Text")...)
+
+	// https://www.postgresql.org/docs/current/datatype-net-types.html
+	mappings = append(mappings, pgxMappings([]string{"inet", "cidr"}, "net/netip", "Prefix", "net/netip", "Prefix")...)
+	mappings = append(mappings, pgxMappings([]string{"macaddr", "macaddr8"}, "net", "HardwareAddr", "net", "HardwareAddr")...)
+
+	// https://www.postgresql.org/docs/current/datatype-bit.html
+	mappings = append(mappings, pgtypeMappings([]string{"bit", "bit varying"}, "Bits")...)
+
+	// https://www.postgresql.org/docs/current/datatype-geometric.html
+	mappings = append(mappings, pgtypeMappings([]string{"point"}, "Point")...)
+	mappings = append(mappings, pgtypeMappings([]string{"line"}, "Line")...)
+	mappings = append(mappings, pgtypeMappings([]string{"lseg"}, "Lseg")...)
+	mappings = append(mappings, pgtypeMappings([]string{"box"}, "Box")...)
+	mappings = append(mappings, pgtypeMappings([]string{"path"}, "Path")...)
+	mappings = append(mappings, pgtypeMappings([]string{"polygon"}, "Polygon")...)
+	mappings = append(mappings, pgtypeMappings([]string{"circle"}, "Circle")...)
+
+	// https://www.postgresql.org/docs/current/datatype-textsearch.html
+	mappings = append(mappings, pgxMappings([]string{"tsvector", "tsquery"}, "", "string", pgtypePkg, "Text")...)
+
+	// https://www.postgresql.org/docs/current/datatype-pg-lsn.html
+	mappings = append(mappings, pgxMappings([]string{"pg_lsn"}, "", "string", pgtypePkg, "Text")...)
+
+	// https://www.postgresql.org/docs/current/datatype-oid.html
+	mappings = append(mappings, pgxMappings([]string{"oid"}, "", "uint32", pgtypePkg, "Uint32")...)
+
+	// https://www.postgresql.org/docs/current/rangetypes.html
+	// the element type is written with the default package name since GoType is a single identifier
+	for _, r := range []struct{ prefix, elemType string }{
+		{prefix: "int4", elemType: "Int4"},
+		{prefix: "int8", elemType: "Int8"},
+		{prefix: "num", elemType: "Numeric"},
+		{prefix: "ts", elemType: "Timestamp"},
+		{prefix: "tstz", elemType: "Timestamptz"},
+		{prefix: "date", elemType: "Date"},
+	} {
+		mappings = append(mappings, pgtypeMappings([]string{r.prefix + "range"}, "Range[pgtype."+r.elemType+"]")...)
+		mappings = append(mappings, pgtypeMappings([]string{r.prefix + "multirange"}, "Multirange[pgtype.Range[pgtype."+r.elemType+"]]")...)
+	}
+
+	return mappings
+}
@@ -12,24 +12,55 @@ import (
 )
 
 type SchemaLoader struct {
-	DB     *sql.DB
-	schema string
+	DB      *sql.DB
+	schemas []string
 }
 
-func NewSchemaLoader(db *sql.DB, schema string) *SchemaLoader {
+// NewSchemaLoader returns a loader of the tables and enums in the schemas, which are loaded in the given order.
+func NewSchemaLoader(db *sql.DB, schemas ...string) *SchemaLoader {
 	return &SchemaLoader{
-		DB:     db,
-		schema: schema,
+		DB:      db,
+		schemas: schemas,
 	}
 }
 
 func (s *SchemaLoader) LoadTableSchemas(ctx context.Context) ([]generator.Table, error) {
-	tables, err := s.listTables(ctx, s.schema)
+	var tableSchemas []generator.Table
+	for _, schema := range s.schemas {
+		tables, err := s.loadTableSchemas(ctx, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		tableSchemas = append(tableSchemas, tables...)
+	}
+
+	return tableSchemas, nil
+}
+
+// loadTableSchemas loads the tables of the schema.
+func (s *SchemaLoader) loadTableSchemas(ctx context.Context, schema string) ([]generator.Table, error) {
+	tables, err := s.listTables(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.listColumns(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	constraints, err := s.listConstraints(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := s.listIndexes(ctx, schema)
 	if err != nil {
 		return nil, err
 	}
 
-	columns, err := s.listColumns(ctx, s.schema)
+	foreignKeys, err := s.listForeignKeys(ctx, schema)
 	if err != nil {
 		return nil, err
 	}
@@ -39,39 +70,219 @@ func (s *SchemaLoader) LoadTableSchemas(ctx context.Context) ([]generator.Table,
 		columnSchemas := make([]generator.Column, 0, len(columns))
 		for _, column := range columns {
 			if table.TableName == column.TableName && table.SchemaName == column.SchemaName {
-				columnSchemas = append(columnSchemas, generator.Column{
-					Name:       column.ColumnName,
-					Comment:    column.Comment.String,
-					Type:       column.DataType,
-					IsNullable: strings.ToUpper(column.IsNullable) != "NO",
-					OrderAsc:   column.Position,
+				columnSchema := generator.Column{
+					Name:               column.ColumnName,
+					Comment:            column.Comment.String,
+					Type:               column.DataType,
+					IsNullable:         strings.ToUpper(column.IsNullable) != "NO",
+					OrderAsc:           column.Position,
+					ArrayDims:          column.ArrayDims,
+					ElemType:           column.ElemType.String,
+					Default:            column.Default.String,
+					IdentityGeneration: identityGeneration(column.Identity),
+					IsGenerated:        column.IsGenerated,
+					Length:             int(column.CharMaxLength.Int32),
+					Precision:          int(column.NumericPrecision.Int32),
+					Scale:              int(column.NumericScale.Int32),
+					DatetimePrecision:  int(column.DatetimePrecision.Int32),
+				}
+				// the enum type may be in another schema than the table
+				if column.DataType == "USER-DEFINED" && column.IsEnum {
+					columnSchema.Enum = column.UDTName
+					columnSchema.EnumSchema = column.UDTSchema
+				}
+				if column.ElemType.String == "USER-DEFINED" && column.IsElemEnum {
+					columnSchema.Enum = column.ElemUDTName.String
+					columnSchema.EnumSchema = column.ElemUDTSchema.String
+				}
+
+				columnSchemas = append(columnSchemas, columnSchema)
+			}
+		}
+
+		var (
+			primaryKey        []string
+			uniqueConstraints []generator.UniqueConstraint
+		)
+		for _, constraint := range constraints {
+			if table.TableName != constraint.TableName || table.SchemaName != constraint.SchemaName {
+				continue
+			}
+
+			switch constraint.ConstraintType {
+			case constraintTypePrimaryKey:
+				primaryKey = append(primaryKey, constraint.ColumnName)
+			case constraintTypeUnique:
+				if len(uniqueConstraints) == 0 || uniqueConstraints[len(uniqueConstraints)-1].Name != constraint.ConstraintName {
+					uniqueConstraints = append(uniqueConstraints, generator.UniqueConstraint{Name: constraint.ConstraintName})
+				}
+
+				last := &uniqueConstraints[len(uniqueConstraints)-1]
+				last.Columns = append(last.Columns, constraint.ColumnName)
+			}
+		}
+
+		var indexSchemas []generator.Index
+		for _, index := range indexes {
+			if table.TableName != index.TableName || table.SchemaName != index.SchemaName {
+				continue
+			}
+
+			if len(indexSchemas) == 0 || indexSchemas[len(indexSchemas)-1].Name != index.IndexName {
+				indexSchemas = append(indexSchemas, generator.Index{
+					Name:       index.IndexName,
+					IsUnique:   index.IsUnique,
+					IsPrimary:  index.IsPrimary,
+					IsPartial:  index.IsPartial,
+					Definition: index.Definition,
 				})
 			}
+
+			last := &indexSchemas[len(indexSchemas)-1]
+			if index.ColumnName.Valid {
+				last.Columns = append(last.Columns, index.ColumnName.String)
+			} else {
+				last.HasExpression = true
+			}
+		}
+
+		var foreignKeySchemas []generator.ForeignKey
+		for _, fk := range foreignKeys {
+			if table.TableName != fk.TableName || table.SchemaName != fk.SchemaName {
+				continue
+			}
+
+			if len(foreignKeySchemas) == 0 || foreignKeySchemas[len(foreignKeySchemas)-1].Name != fk.ConstraintName {
+				foreignKeySchemas = append(foreignKeySchemas, generator.ForeignKey{
+					Name:                fk.ConstraintName,
+					RefSchema:           fk.RefSchemaName,
+					RefTable:            fk.RefTableName,
+					OnDelete:            foreignKeyAction(fk.OnDelete),
+					OnUpdate:            foreignKeyAction(fk.OnUpdate),
+					IsDeferrable:        fk.IsDeferrable,
+					IsInitiallyDeferred: fk.IsInitiallyDeferred,
+				})
+			}
+
+			last := &foreignKeySchemas[len(foreignKeySchemas)-1]
+			last.Columns = append(last.Columns, fk.ColumnName)
+			last.RefColumns = append(last.RefColumns, fk.RefColumnName)
 		}
 
 		tableSchemas[i] = generator.Table{
-			Name:    table.TableName,
-			Comment: table.Comment.String,
-			Columns: columnSchemas,
+			Schema:            table.SchemaName,
+			Name:              table.TableName,
+			Kind:              tableKind(table.Kind),
+			Comment:           table.Comment.String,
+			Columns:           columnSchemas,
+			PrimaryKey:        primaryKey,
+			UniqueConstraints: uniqueConstraints,
+			Indexes:           indexSchemas,
+			ForeignKeys:       foreignKeySchemas,
 		}
 	}
 
 	return tableSchemas, nil
 }
 
+func tableKind(relkind string) generator.TableKind {
+	switch relkind {
+	case relkindView:
+		return generator.TableKindView
+	case relkindMaterializedView:
+		return generator.TableKindMaterializedView
+	default:
+		return generator.TableKindTable
+	}
+}
+
+// identityGeneration converts attidentity of pg_attribute.
+func identityGeneration(identity string) generator.IdentityGeneration {
+	switch identity {
+	case attidentityAlways:
+		return generator.IdentityGenerationAlways
+	case attidentityByDefault:
+		return generator.IdentityGenerationByDefault
+	default:
+		return ""
+	}
+}
+
+// foreignKeyAction converts confdeltype and confupdtype of pg_constraint.
+func foreignKeyAction(action string) generator.ForeignKeyAction {
+	switch action {
+	case foreignKeyActionRestrict:
+		return generator.ForeignKeyActionRestrict
+	case foreignKeyActionCascade:
+		return generator.ForeignKeyActionCascade
+	case foreignKeyActionSetNull:
+		return generator.ForeignKeyActionSetNull
+	case foreignKeyActionSetDefault:
+		return generator.ForeignKeyActionSetDefault
+	default:
+		return generator.ForeignKeyActionNoAction
+	}
+}
+
+func (s *SchemaLoader) LoadEnums(ctx context.Context) ([]generator.Enum, error) {
+	var enumSchemas []generator.Enum
+	for _, schema := range s.schemas {
+		enums, err := s.listEnums(ctx, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		// the values of an enum are listed consecutively, so a new enum starts when the name changes
+		first := len(enumSchemas)
+		for _, enum := range enums {
+			if len(enumSchemas) == first || enumSchemas[len(enumSchemas)-1].Name != enum.TypeName {
+				enumSchemas = append(enumSchemas, generator.Enum{
+					Schema:  schema,
+					Name:    enum.TypeName,
+					Comment: enum.Comment.String,
+				})
+			}
+
+			last := &enumSchemas[len(enumSchemas)-1]
+			last.Values = append(last.Values, enum.Label)
+		}
+	}
+
+	return enumSchemas, nil
+}
+
+const (
+	relkindView             = "v"
+	relkindMaterializedView = "m"
+)
+
 type Table struct {
 	ID         int            `db:"relid"`
 	SchemaName string         `db:"schemaname"`
 	TableName  string         `db:"relname"`
+	Kind       string         `db:"relkind"`
 	Comment    sql.NullString `db:"description"`
 }
 
 func (s *SchemaLoader) listTables(ctx context.Context, schema string) ([]Table, error) {
-	const query = "SELECT relid, schemaname, relname, d.description " +
-		"FROM pg_stat_user_tables sut " +
-		"LEFT JOIN pg_description d ON d.objsubid = 0 AND sut.relid = d.objoid " +
-		"WHERE sut.schemaname = $1;"
-	slog.Debug("executing query", "query", query, "schema", schema)
+	const query = `
+SELECT
+	c.oid AS relid,
+	n.nspname AS schemaname,
+	c.relname,
+	c.relkind::text AS relkind,
+	d.description
+FROM
+	pg_class AS c
+	JOIN pg_namespace AS n ON n.oid = c.relnamespace
+	LEFT JOIN pg_description AS d ON d.objoid = c.oid AND d.objsubid = 0 AND d.classoid = 'pg_class'::regclass
+WHERE
+	n.nspname = $1
+	AND c.relkind IN ('r', 'p', 'v', 'm')
+ORDER BY
+	c.relname ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "schema", schema)
 
 	rows, err := s.DB.QueryContext(ctx, query, schema)
 	if err != nil {
@@ -85,6 +296,7 @@ func (s *SchemaLoader) listTables(ctx context.Context, schema string) ([]Table,
 			&table.ID,
 			&table.SchemaName,
 			&table.TableName,
+			&table.Kind,
 			&table.Comment,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan tables: %w", err)
@@ -96,79 +308,197 @@ func (s *SchemaLoader) listTables(ctx context.Context, schema string) ([]Table,
 	return tables, nil
 }
 
+const (
+	attidentityAlways    = "a"
+	attidentityByDefault = "d"
+)
+
 type Column struct {
-	SchemaName string         `db:"table_schema"`
-	TableName  string         `db:"table_name"`
-	ColumnName string         `db:"column_name"`
-	DataType   string         `db:"data_type"`
-	IsNullable string         `db:"is_nullable"`
-	Position   int            `db:"ordinal_position"`
-	Comment    sql.NullString `db:"description"`
-	FromTable  sql.NullString `db:"from_table_name"`
-	FromColumn sql.NullString `db:"from_column_name"`
-	ToTable    sql.NullString `db:"to_table_name"`
-	ToColumn   sql.NullString `db:"to_column_name"`
+	SchemaName    string         `db:"table_schema"`
+	TableName     string         `db:"table_name"`
+	ColumnName    string         `db:"column_name"`
+	DataType      string         `db:"data_type"`
+	UDTSchema     string         `db:"udt_schema"`
+	UDTName       string         `db:"udt_name"`
+	IsEnum        bool           `db:"is_enum"`
+	IsNullable    string         `db:"is_nullable"`
+	Position      int            `db:"ordinal_position"`
+	Comment       sql.NullString `db:"description"`
+	ArrayDims     int            `db:"array_dims"`
+	ElemType      sql.NullString `db:"elem_type"`
+	ElemUDTSchema sql.NullString `db:"elem_udt_schema"`
+	ElemUDTName   sql.NullString `db:"elem_udt_name"`
+	IsElemEnum    bool           `db:"is_elem_enum"`
+	Default       sql.NullString `db:"column_default"`
+	Identity      string         `db:"attidentity"`
+	IsGenerated   bool           `db:"is_generated"`
+	// the sizes are NULL when they do not apply to the type
+	CharMaxLength     sql.NullInt32 `db:"character_maximum_length"`
+	NumericPrecision  sql.NullInt32 `db:"numeric_precision"`
+	NumericScale      sql.NullInt32 `db:"numeric_scale"`
+	DatetimePrecision sql.NullInt32 `db:"datetime_precision"`
 }
 
 func (s *SchemaLoader) listColumns(ctx context.Context, schema string) ([]Column, error) {
 	const query = `
-WITH column_list AS (
+WITH single_source_view AS (
+SELECT
+	r.ev_class AS view_oid,
+	min(d.refobjid) AS source_oid
+FROM
+	pg_rewrite AS r
+	JOIN pg_depend AS d ON d.classid = 'pg_rewrite'::regclass AND d.objid = r.oid AND d.refclassid = 'pg_class'::regclass
+WHERE
+	d.refobjid <> r.ev_class
+GROUP BY
+	r.ev_class
+HAVING
+	count(DISTINCT d.refobjid) = 1
+),
+-- view columns are always nullable in the catalog. a column is inferred NOT NULL only when the view reads from a
+-- single relation without subqueries, aggregates, grouping sets or outer joins, and the column is a plain reference
+-- to a NOT NULL column of the relation, which resorigtbl and resorigcol of the target entry point to.
+not_null_view_column AS (
+SELECT
+	r.ev_class AS view_oid,
+	tle[1]::int2 AS attnum
+FROM
+	pg_rewrite AS r
+	JOIN single_source_view AS sv ON sv.view_oid = r.ev_class
+	CROSS JOIN LATERAL regexp_matches(
+		r.ev_action::text,
+		':resno (\d+) :resname (?:\\.|[^ ])+ :ressortgroupref \d+ :resorigtbl (\d+) :resorigcol (\d+)',
+		'g'
+	) AS tle
+	JOIN pg_attribute AS sa ON sa.attrelid = tle[2]::oid AND sa.attnum = tle[3]::int2
+WHERE
+	r.rulename = '_RETURN'
+	AND tle[2]::oid = sv.source_oid
+	AND sa.attnotnull
+	AND NOT sa.attisdropped
+	AND r.ev_action::text !~ '\{QUERY.*\{QUERY'
+	AND r.ev_action::text ~ ':hasAggs false'
+	AND r.ev_action::text ~ ':groupingSets <>'
+	AND r.ev_action::text !~ ':jointype [1-9]'
+),
+column_list AS (
 SELECT
-	c.table_schema || '_' || c.table_name || '_' || c.column_name AS column_key,
-	c.table_schema,
-	c.table_name,
-	c.column_name,
-	c.data_type,
-	c.is_nullable,
-	c.ordinal_position,
+	nc.nspname AS table_schema,
+	c.relname AS table_name,
+	a.attname AS column_name,
+	CASE
+		WHEN t.typtype = 'd' THEN
+			CASE
+				WHEN bt.typelem <> 0 AND bt.typlen = -1 THEN 'ARRAY'
+				WHEN nbt.nspname = 'pg_catalog' THEN format_type(t.typbasetype, NULL)
+				ELSE 'USER-DEFINED'
+			END
+		ELSE
+			CASE
+				WHEN t.typelem <> 0 AND t.typlen = -1 THEN 'ARRAY'
+				WHEN nt.nspname = 'pg_catalog' THEN format_type(a.atttypid, NULL)
+				ELSE 'USER-DEFINED'
+			END
+	END AS data_type,
+	COALESCE(nbt.nspname, nt.nspname) AS udt_schema,
+	COALESCE(bt.typname, t.typname) AS udt_name,
+	COALESCE(bt.typtype, t.typtype) = 'e' AS is_enum,
+	CASE
+		WHEN c.relkind IN ('v', 'm') THEN
+			CASE
+				WHEN EXISTS (SELECT 1 FROM not_null_view_column AS nv WHERE nv.view_oid = c.oid AND nv.attnum = a.attnum) THEN 'NO'
+				ELSE 'YES'
+			END
+		WHEN a.attnotnull OR (t.typtype = 'd' AND t.typnotnull) THEN 'NO'
+		ELSE 'YES'
+	END AS is_nullable,
+	a.attnum AS ordinal_position,
 	(
 		SELECT
 			description
 		FROM
 			pg_description
 		WHERE
-			pg_description.objoid = pg_stat_user_tables.relid
-			AND pg_description.objsubid = c.ordinal_position
-	) AS description
+			pg_description.objoid = c.oid
+			AND pg_description.objsubid = a.attnum
+	) AS description,
+	CASE WHEN et.oid IS NOT NULL THEN GREATEST(a.attndims, 1) ELSE 0 END AS array_dims,
+	CASE
+		WHEN et.oid IS NULL THEN NULL
+		WHEN net.nspname = 'pg_catalog' THEN format_type(et.oid, NULL)
+		ELSE 'USER-DEFINED'
+	END AS elem_type,
+	net.nspname AS elem_udt_schema,
+	et.typname AS elem_udt_name,
+	COALESCE(et.typtype = 'e', false) AS is_elem_enum,
+	-- the expression of a generated column is not a default
+	CASE WHEN a.attgenerated = '' THEN pg_get_expr(ad.adbin, ad.adrelid) END AS column_default,
+	a.attidentity::text AS attidentity,
+	a.attgenerated <> '' AS is_generated,
+	-- the sizes are computed as information_schema.columns does, through the base type of domains
+	information_schema._pg_char_max_length(
+		information_schema._pg_truetypid(a.*, t.*),
+		information_schema._pg_truetypmod(a.*, t.*)
+	)::int AS character_maximum_length,
+	-- precision and scale are implied by the type except for numeric
+	CASE WHEN information_schema._pg_truetypid(a.*, t.*) = 'numeric'::regtype THEN
+		information_schema._pg_numeric_precision(
+			information_schema._pg_truetypid(a.*, t.*),
+			information_schema._pg_truetypmod(a.*, t.*)
+		)::int
+	END AS numeric_precision,
+	CASE WHEN information_schema._pg_truetypid(a.*, t.*) = 'numeric'::regtype THEN
+		information_schema._pg_numeric_scale(
+			information_schema._pg_truetypid(a.*, t.*),
+			information_schema._pg_truetypmod(a.*, t.*)
+		)::int
+	END AS numeric_scale,
+	information_schema._pg_datetime_precision(
+		information_schema._pg_truetypid(a.*, t.*),
+		information_schema._pg_truetypmod(a.*, t.*)
+	)::int AS datetime_precision
 FROM
-	pg_stat_user_tables,
-	information_schema.columns c
+	pg_attribute AS a
+	JOIN pg_class AS c ON c.oid = a.attrelid
+	JOIN pg_namespace AS nc ON nc.oid = c.relnamespace
+	JOIN pg_type AS t ON t.oid = a.atttypid
+	JOIN pg_namespace AS nt ON nt.oid = t.typnamespace
+	LEFT JOIN (pg_type AS bt JOIN pg_namespace AS nbt ON nbt.oid = bt.typnamespace)
+		ON t.typtype = 'd' AND t.typbasetype = bt.oid
+	LEFT JOIN (pg_type AS et JOIN pg_namespace AS net ON net.oid = et.typnamespace)
+		ON et.oid = COALESCE(bt.typelem, t.typelem) AND COALESCE(bt.typlen, t.typlen) = -1
+	LEFT JOIN pg_attrdef AS ad ON ad.adrelid = a.attrelid AND ad.adnum = a.attnum
 WHERE
-	pg_stat_user_tables.relname = c.table_name
-	AND pg_stat_user_tables.schemaname = $1
-),
-relation_list AS (
-SELECT
-	t.table_schema || '_' || t.table_name || '_' || k.column_name AS column_key,
-	t.table_schema AS table_schema,
-	k.table_name AS from_table_name,
-	k.column_name AS from_column_name,
-	c.table_name AS to_table_name,
-	c.column_name AS to_column_name
-FROM
-	information_schema.table_constraints as t,
-	information_schema.key_column_usage as k,
-	information_schema.constraint_column_usage as c
-WHERE
-	t.constraint_type = 'FOREIGN KEY'
-	AND t.constraint_name = k.constraint_name
-	AND t.constraint_name = c.constraint_name
+	nc.nspname = $1
+	AND c.relkind IN ('r', 'p', 'v', 'm')
+	AND a.attnum > 0
+	AND NOT a.attisdropped
 )
 SELECT
 	col.table_schema,
 	col.table_name,
 	col.column_name,
 	col.data_type,
+	col.udt_schema,
+	col.udt_name,
+	col.is_enum,
 	col.is_nullable,
 	col.ordinal_position,
 	col.description,
-	rel.from_table_name,
-	rel.from_column_name,
-	rel.to_table_name,
-	rel.to_column_name
+	col.array_dims,
+	col.elem_type,
+	col.elem_udt_schema,
+	col.elem_udt_name,
+	col.is_elem_enum,
+	col.column_default,
+	col.attidentity,
+	col.is_generated,
+	col.character_maximum_length,
+	col.numeric_precision,
+	col.numeric_scale,
+	col.datetime_precision
 FROM
 	column_list AS col
-	LEFT JOIN relation_list AS rel ON col.column_key = rel.column_key
 ORDER BY
 	col.table_name ASC,
 	col.ordinal_position ASC
@@ -188,13 +518,24 @@ ORDER BY
 			&column.TableName,
 			&column.ColumnName,
 			&column.DataType,
+			&column.UDTSchema,
+			&column.UDTName,
+			&column.IsEnum,
 			&column.IsNullable,
 			&column.Position,
 			&column.Comment,
-			&column.FromTable,
-			&column.FromColumn,
-			&column.ToTable,
-			&column.ToColumn,
+			&column.ArrayDims,
+			&column.ElemType,
+			&column.ElemUDTSchema,
+			&column.ElemUDTName,
+			&column.IsElemEnum,
+			&column.Default,
+			&column.Identity,
+			&column.IsGenerated,
+			&column.CharMaxLength,
+			&column.NumericPrecision,
+			&column.NumericScale,
+			&column.DatetimePrecision,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan columns: %w", err)
 		}
@@ -205,6 +546,277 @@ ORDER BY
 	return columns, nil
 }
 
+const (
+	constraintTypePrimaryKey = "p"
+	constraintTypeUnique     = "u"
+)
+
+type Constraint struct {
+	SchemaName     string `db:"schema_name"`
+	TableName      string `db:"table_name"`
+	ConstraintName string `db:"constraint_name"`
+	ConstraintType string `db:"constraint_type"`
+	ColumnName     string `db:"column_name"`
+}
+
+// listConstraints lists primary key and unique constraints with one row per constrained column,
+// ordered by the column order of each constraint.
+func (s *SchemaLoader) listConstraints(ctx context.Context, schema string) ([]Constraint, error) {
+	const query = `
+SELECT
+	n.nspname AS schema_name,
+	c.relname AS table_name,
+	con.conname AS constraint_name,
+	con.contype::text AS constraint_type,
+	a.attname AS column_name
+FROM
+	pg_constraint AS con
+	JOIN pg_class AS c ON c.oid = con.conrelid
+	JOIN pg_namespace AS n ON n.oid = c.relnamespace
+	CROSS JOIN LATERAL unnest(con.conkey) WITH ORDINALITY AS k(attnum, ord)
+	JOIN pg_attribute AS a ON a.attrelid = con.conrelid AND a.attnum = k.attnum
+WHERE
+	con.contype IN ('p', 'u')
+	AND n.nspname = $1
+ORDER BY
+	c.relname ASC,
+	con.conname ASC,
+	k.ord ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "schema", schema)
+
+	rows, err := s.DB.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var constraints []Constraint
+	for rows.Next() {
+		var constraint Constraint
+		if err := rows.Scan(
+			&constraint.SchemaName,
+			&constraint.TableName,
+			&constraint.ConstraintName,
+			&constraint.ConstraintType,
+			&constraint.ColumnName,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan constraints: %w", err)
+		}
+
+		constraints = append(constraints, constraint)
+	}
+
+	return constraints, rows.Err()
+}
+
+const (
+	foreignKeyActionRestrict   = "r"
+	foreignKeyActionCascade    = "c"
+	foreignKeyActionSetNull    = "n"
+	foreignKeyActionSetDefault = "d"
+)
+
+type ForeignKey struct {
+	SchemaName          string `db:"schema_name"`
+	TableName           string `db:"table_name"`
+	ConstraintName      string `db:"constraint_name"`
+	ColumnName          string `db:"column_name"`
+	RefSchemaName       string `db:"ref_schema_name"`
+	RefTableName        string `db:"ref_table_name"`
+	RefColumnName       string `db:"ref_column_name"`
+	OnDelete            string `db:"confdeltype"`
+	OnUpdate            string `db:"confupdtype"`
+	IsDeferrable        bool   `db:"condeferrable"`
+	IsInitiallyDeferred bool   `db:"condeferred"`
+}
+
+// listForeignKeys lists foreign keys with one row per pair of the column and the referenced column,
+// ordered by the position of the first column of each foreign key and then by the column order of the foreign key.
+func (s *SchemaLoader) listForeignKeys(ctx context.Context, schema string) ([]ForeignKey, error) {
+	const query = `
+SELECT
+	n.nspname AS schema_name,
+	c.relname AS table_name,
+	con.conname AS constraint_name,
+	a.attname AS column_name,
+	rn.nspname AS ref_schema_name,
+	rc.relname AS ref_table_name,
+	ra.attname AS ref_column_name,
+	con.confdeltype::text AS confdeltype,
+	con.confupdtype::text AS confupdtype,
+	con.condeferrable,
+	con.condeferred
+FROM
+	pg_constraint AS con
+	JOIN pg_class AS c ON c.oid = con.conrelid
+	JOIN pg_namespace AS n ON n.oid = c.relnamespace
+	JOIN pg_class AS rc ON rc.oid = con.confrelid
+	JOIN pg_namespace AS rn ON rn.oid = rc.relnamespace
+	CROSS JOIN LATERAL unnest(con.conkey, con.confkey) WITH ORDINALITY AS k(attnum, ref_attnum, ord)
+	JOIN pg_attribute AS a ON a.attrelid = con.conrelid AND a.attnum = k.attnum
+	JOIN pg_attribute AS ra ON ra.attrelid = con.confrelid AND ra.attnum = k.ref_attnum
+WHERE
+	con.contype = 'f'
+	AND n.nspname = $1
+ORDER BY
+	c.relname ASC,
+	(SELECT min(attnum) FROM unnest(con.conkey) AS attnum) ASC,
+	con.conname ASC,
+	k.ord ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "schema", schema)
+
+	rows, err := s.DB.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var foreignKeys []ForeignKey
+	for rows.Next() {
+		var fk ForeignKey
+		if err := rows.Scan(
+			&fk.SchemaName,
+			&fk.TableName,
+			&fk.ConstraintName,
+			&fk.ColumnName,
+			&fk.RefSchemaName,
+			&fk.RefTableName,
+			&fk.RefColumnName,
+			&fk.OnDelete,
+			&fk.OnUpdate,
+			&fk.IsDeferrable,
+			&fk.IsInitiallyDeferred,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan foreign keys: %w", err)
+		}
+
+		foreignKeys = append(foreignKeys, fk)
+	}
+
+	return foreignKeys, rows.Err()
+}
+
+type Index struct {
+	SchemaName string         `db:"schema_name"`
+	TableName  string         `db:"table_name"`
+	IndexName  string         `db:"index_name"`
+	IsUnique   bool           `db:"indisunique"`
+	IsPrimary  bool           `db:"indisprimary"`
+	IsPartial  bool           `db:"is_partial"`
+	Definition string         `db:"definition"`
+	ColumnName sql.NullString `db:"column_name"`
+}
+
+// listIndexes lists indexes with one row per key column. column_name is NULL for expression keys.
+func (s *SchemaLoader) listIndexes(ctx context.Context, schema string) ([]Index, error) {
+	const query = `
+SELECT
+	n.nspname AS schema_name,
+	t.relname AS table_name,
+	i.relname AS index_name,
+	ix.indisunique,
+	ix.indisprimary,
+	ix.indpred IS NOT NULL AS is_partial,
+	pg_get_indexdef(ix.indexrelid) AS definition,
+	a.attname AS column_name
+FROM
+	pg_index AS ix
+	JOIN pg_class AS i ON i.oid = ix.indexrelid
+	JOIN pg_class AS t ON t.oid = ix.indrelid
+	JOIN pg_namespace AS n ON n.oid = t.relnamespace
+	CROSS JOIN LATERAL unnest(ix.indkey::smallint[]) WITH ORDINALITY AS k(attnum, ord)
+	LEFT JOIN pg_attribute AS a ON a.attrelid = ix.indrelid AND a.attnum = k.attnum AND k.attnum > 0
+WHERE
+	n.nspname = $1
+	AND k.ord <= ix.indnkeyatts
+ORDER BY
+	t.relname ASC,
+	i.relname ASC,
+	k.ord ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "schema", schema)
+
+	rows, err := s.DB.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var indexes []Index
+	for rows.Next() {
+		var index Index
+		if err := rows.Scan(
+			&index.SchemaName,
+			&index.TableName,
+			&index.IndexName,
+			&index.IsUnique,
+			&index.IsPrimary,
+			&index.IsPartial,
+			&index.Definition,
+			&index.ColumnName,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan indexes: %w", err)
+		}
+
+		indexes = append(indexes, index)
+	}
+
+	return indexes, rows.Err()
+}
+
+type Enum struct {
+	SchemaName string         `db:"schema_name"`
+	TypeName   string         `db:"type_name"`
+	Label      string         `db:"enumlabel"`
+	Comment    sql.NullString `db:"description"`
+}
+
+// listEnums lists enum types with one row per label, ordered by the sort order of the labels.
+func (s *SchemaLoader) listEnums(ctx context.Context, schema string) ([]Enum, error) {
+	const query = `
+SELECT
+	n.nspname AS schema_name,
+	t.typname AS type_name,
+	e.enumlabel,
+	obj_description(t.oid, 'pg_type') AS description
+FROM
+	pg_type AS t
+	JOIN pg_enum AS e ON e.enumtypid = t.oid
+	JOIN pg_namespace AS n ON n.oid = t.typnamespace
+WHERE
+	n.nspname = $1
+ORDER BY
+	t.typname ASC,
+	e.enumsortorder ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "schema", schema)
+
+	rows, err := s.DB.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var enums []Enum
+	for rows.Next() {
+		var enum Enum
+		if err := rows.Scan(
+			&enum.SchemaName,
+			&enum.TypeName,
+			&enum.Label,
+			&enum.Comment,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan enums: %w", err)
+		}
+
+		enums = append(enums, enum)
+	}
+
+	return enums, rows.Err()
+}
+
 func normalizeQuery(query string) string {
 	tabAndNewlineRegex := regexp.MustCompile("[\t\n]")
 	replaced := tabAndNewlineRegex.ReplaceAllString(query, " ")
@@ -216,3 +828,7 @@ func normalizeQuery(query string) string {
 
 	return replaced
 }
+
+func (s *SchemaLoader) Dialect() generator.Dialect {
+	return generator.DialectPostgres
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/kmtym1998/chair/generator"
@@ -70,6 +71,162 @@ var ddlList = []string{
 		"uuid_value_nullable UUID," +
 		"uuid_value UUID NOT NULL" +
 		");",
+	"CREATE TABLE public.json_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"json_value_nullable JSON," +
+		"jsonb_value_nullable JSONB," +
+		"jsonpath_value_nullable JSONPATH," +
+		"json_value JSON NOT NULL," +
+		"jsonb_value JSONB NOT NULL," +
+		"jsonpath_value JSONPATH NOT NULL" +
+		");",
+	"CREATE TABLE public.binary_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"bytea_value_nullable BYTEA," +
+		"bytea_value BYTEA NOT NULL" +
+		");",
+	"CREATE TABLE public.network_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"inet_value_nullable INET," +
+		"cidr_value_nullable CIDR," +
+		"macaddr_value_nullable MACADDR," +
+		"macaddr8_value_nullable MACADDR8," +
+		"inet_value INET NOT NULL," +
+		"cidr_value CIDR NOT NULL," +
+		"macaddr_value MACADDR NOT NULL," +
+		"macaddr8_value MACADDR8 NOT NULL" +
+		");",
+	"CREATE TABLE public.bit_string_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"bit_value_nullable BIT(8)," +
+		"bit_varying_value_nullable BIT VARYING(8)," +
+		"bit_value BIT(8) NOT NULL," +
+		"bit_varying_value BIT VARYING(8) NOT NULL" +
+		");",
+	"CREATE TABLE public.xml_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"xml_value_nullable XML," +
+		"xml_value XML NOT NULL" +
+		");",
+	"CREATE TABLE public.text_search_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"tsvector_value_nullable TSVECTOR," +
+		"tsquery_value_nullable TSQUERY," +
+		"tsvector_value TSVECTOR NOT NULL," +
+		"tsquery_value TSQUERY NOT NULL" +
+		");",
+	"CREATE TABLE public.geometric_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"point_value_nullable POINT," +
+		"line_value_nullable LINE," +
+		"lseg_value_nullable LSEG," +
+		"box_value_nullable BOX," +
+		"path_value_nullable PATH," +
+		"polygon_value_nullable POLYGON," +
+		"circle_value_nullable CIRCLE," +
+		"point_value POINT NOT NULL," +
+		"line_value LINE NOT NULL," +
+		"lseg_value LSEG NOT NULL," +
+		"box_value BOX NOT NULL," +
+		"path_value PATH NOT NULL," +
+		"polygon_value POLYGON NOT NULL," +
+		"circle_value CIRCLE NOT NULL" +
+		");",
+	"CREATE TABLE public.range_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"int4range_value_nullable INT4RANGE," +
+		"int8range_value_nullable INT8RANGE," +
+		"numrange_value_nullable NUMRANGE," +
+		"tsrange_value_nullable TSRANGE," +
+		"tstzrange_value_nullable TSTZRANGE," +
+		"daterange_value_nullable DATERANGE," +
+		"int4range_value INT4RANGE NOT NULL," +
+		"int8range_value INT8RANGE NOT NULL," +
+		"numrange_value NUMRANGE NOT NULL," +
+		"tsrange_value TSRANGE NOT NULL," +
+		"tstzrange_value TSTZRANGE NOT NULL," +
+		"daterange_value DATERANGE NOT NULL" +
+		");",
+	"CREATE TABLE public.composite_key_types (" +
+		"tenant_id INTEGER NOT NULL," +
+		"code VARCHAR(255) NOT NULL," +
+		"unique_value TEXT NOT NULL UNIQUE," +
+		"indexed_value TEXT," +
+		"PRIMARY KEY (tenant_id, code)" +
+		");",
+	"CREATE INDEX composite_key_types_indexed_value_idx ON public.composite_key_types (indexed_value);",
+	"CREATE UNIQUE INDEX composite_key_types_lower_indexed_value_idx ON public.composite_key_types (lower(indexed_value));",
+	"CREATE TABLE public.composite_key_references (" +
+		"id SERIAL PRIMARY KEY," +
+		"tenant_id INTEGER NOT NULL," +
+		"code VARCHAR(255) NOT NULL," +
+		"CONSTRAINT composite_key_references_fkey FOREIGN KEY (tenant_id, code) REFERENCES public.composite_key_types (tenant_id, code) " +
+		"ON DELETE CASCADE ON UPDATE RESTRICT DEFERRABLE INITIALLY DEFERRED" +
+		");",
+	"CREATE TABLE public.default_types (" +
+		"id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY," +
+		"code INTEGER GENERATED BY DEFAULT AS IDENTITY," +
+		"status TEXT NOT NULL DEFAULT 'active'," +
+		"created_at TIMESTAMPTZ NOT NULL DEFAULT now()," +
+		"price INTEGER NOT NULL," +
+		"qty INTEGER NOT NULL DEFAULT 1," +
+		"total INTEGER GENERATED ALWAYS AS (price * qty) STORED" +
+		");",
+	"CREATE TABLE public.sized_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"code CHAR(3) NOT NULL," +
+		"amount NUMERIC(12, 2) NOT NULL," +
+		"quantity NUMERIC(10, 0) NOT NULL," +
+		"occurred_at TIMESTAMPTZ(3) NOT NULL" +
+		");",
+	"CREATE TABLE public.authors (" +
+		"id SERIAL PRIMARY KEY," +
+		"name TEXT NOT NULL" +
+		");",
+	"CREATE TABLE public.books (" +
+		"id SERIAL PRIMARY KEY," +
+		"author_id INTEGER NOT NULL REFERENCES public.authors (id)," +
+		"title TEXT NOT NULL" +
+		");",
+	"CREATE TABLE public.author_profiles (" +
+		"id SERIAL PRIMARY KEY," +
+		"author_id INTEGER NOT NULL UNIQUE REFERENCES public.authors (id)," +
+		"bio TEXT" +
+		");",
+	"CREATE TYPE public.mood AS ENUM ('sad', 'ok', 'happy');",
+	"COMMENT ON TYPE public.mood IS 'mood of a person';",
+	"CREATE TABLE public.enum_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"mood_value_nullable public.mood," +
+		"mood_value public.mood NOT NULL" +
+		");",
+	"CREATE TABLE public.array_types (" +
+		"id SERIAL PRIMARY KEY," +
+		"text_array_value_nullable TEXT[]," +
+		"integer_array_value INTEGER[] NOT NULL," +
+		"uuid_array_value UUID[] NOT NULL," +
+		"timestamp_array_value TIMESTAMP[] NOT NULL," +
+		"integer_matrix_value INTEGER[][] NOT NULL," +
+		"mood_array_value public.mood[] NOT NULL" +
+		");",
+	"CREATE VIEW public.active_authors AS SELECT id, name FROM public.authors WHERE name <> '';",
+	"COMMENT ON VIEW public.active_authors IS 'authors with a name';",
+	// the outer join and the expression make the columns nullable
+	"CREATE VIEW public.author_books AS SELECT a.id, upper(a.name) AS upper_name, b.title " +
+		"FROM public.authors AS a LEFT JOIN public.books AS b ON b.author_id = a.id;",
+	"CREATE MATERIALIZED VIEW public.book_counts AS SELECT author_id, count(*) AS book_count FROM public.books GROUP BY author_id;",
+	"CREATE SCHEMA billing;",
+	"CREATE TYPE billing.invoice_status AS ENUM ('draft', 'paid');",
+	"CREATE TABLE billing.invoices (" +
+		"id SERIAL PRIMARY KEY," +
+		"status billing.invoice_status NOT NULL," +
+		"mood public.mood" +
+		");",
+	// the constraint name is the same as the one of public.books
+	"CREATE TABLE billing.books (" +
+		"id SERIAL PRIMARY KEY," +
+		"author_id INTEGER REFERENCES public.authors (id) ON DELETE SET NULL" +
+		");",
 }
 
 func TestLoadTableSchemas(t *testing.T) {
@@ -119,22 +276,30 @@ func TestLoadTableSchemas(t *testing.T) {
 
 			expected := []generator.Table{
 				{
-					Name: "character_types",
+					Schema: "public",
+					Name:   "character_types",
+					Kind:   generator.TableKindTable,
 					Columns: []generator.Column{
-						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
-						{Name: "character_value_nullable", Type: "character", IsNullable: true, OrderAsc: 2},
-						{Name: "character_varying_value_nullable", Type: "character varying", IsNullable: true, OrderAsc: 3},
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('character_types_id_seq'::regclass)"},
+						{Name: "character_value_nullable", Type: "character", IsNullable: true, OrderAsc: 2, Length: 1},
+						{Name: "character_varying_value_nullable", Type: "character varying", IsNullable: true, OrderAsc: 3, Length: 255},
 						{Name: "text_value_nullable", Type: "text", IsNullable: true, OrderAsc: 4},
-						{Name: "character_value", Type: "character", IsNullable: false, OrderAsc: 5},
-						{Name: "character_varying_value", Type: "character varying", IsNullable: false, OrderAsc: 6},
+						{Name: "character_value", Type: "character", IsNullable: false, OrderAsc: 5, Length: 1},
+						{Name: "character_varying_value", Type: "character varying", IsNullable: false, OrderAsc: 6, Length: 255},
 						{Name: "text_value", Type: "text", IsNullable: false, OrderAsc: 7},
 					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "character_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX character_types_pkey ON public.character_types USING btree (id)"},
+					},
 				},
 				{
+					Schema:  "public",
 					Name:    "numeric_types",
+					Kind:    generator.TableKindTable,
 					Comment: "numeric types",
 					Columns: []generator.Column{
-						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('numeric_types_id_seq'::regclass)"},
 						{Name: "smallint_value_nullable", Type: "smallint", IsNullable: true, OrderAsc: 2, Comment: "smallint value nullable"},
 						{Name: "integer_value_nullable", Type: "integer", IsNullable: true, OrderAsc: 3, Comment: "integer value nullable"},
 						{Name: "bigint_value_nullable", Type: "bigint", IsNullable: true, OrderAsc: 4},
@@ -149,55 +314,425 @@ func TestLoadTableSchemas(t *testing.T) {
 						{Name: "numeric_value", Type: "numeric", IsNullable: false, OrderAsc: 13},
 						{Name: "real_value", Type: "real", IsNullable: false, OrderAsc: 14},
 						{Name: "double_precision_value", Type: "double precision", IsNullable: false, OrderAsc: 15},
-						{Name: "smallserial_value", Type: "smallint", IsNullable: false, OrderAsc: 16},
-						{Name: "serial_value", Type: "integer", IsNullable: false, OrderAsc: 17},
-						{Name: "bigserial_value", Type: "bigint", IsNullable: false, OrderAsc: 18},
+						{Name: "smallserial_value", Type: "smallint", IsNullable: false, OrderAsc: 16, Default: "nextval('numeric_types_smallserial_value_seq'::regclass)"},
+						{Name: "serial_value", Type: "integer", IsNullable: false, OrderAsc: 17, Default: "nextval('numeric_types_serial_value_seq'::regclass)"},
+						{Name: "bigserial_value", Type: "bigint", IsNullable: false, OrderAsc: 18, Default: "nextval('numeric_types_bigserial_value_seq'::regclass)"},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "numeric_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX numeric_types_pkey ON public.numeric_types USING btree (id)"},
 					},
 				},
 				{
-					Name: "datetime_types",
+					Schema: "public",
+					Name:   "datetime_types",
+					Kind:   generator.TableKindTable,
 					Columns: []generator.Column{
-						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('datetime_types_id_seq'::regclass)"},
 						{Name: "date_value_nullable", Type: "date", IsNullable: true, OrderAsc: 2},
-						{Name: "time_value_nullable", Type: "time without time zone", IsNullable: true, OrderAsc: 3},
-						{Name: "timestamp_value_nullable", Type: "timestamp without time zone", IsNullable: true, OrderAsc: 4},
-						{Name: "timestamptz_value_nullable", Type: "timestamp with time zone", IsNullable: true, OrderAsc: 5},
-						{Name: "interval_value_nullable", Type: "interval", IsNullable: true, OrderAsc: 6},
+						{Name: "time_value_nullable", Type: "time without time zone", IsNullable: true, OrderAsc: 3, DatetimePrecision: 6},
+						{Name: "timestamp_value_nullable", Type: "timestamp without time zone", IsNullable: true, OrderAsc: 4, DatetimePrecision: 6},
+						{Name: "timestamptz_value_nullable", Type: "timestamp with time zone", IsNullable: true, OrderAsc: 5, DatetimePrecision: 6},
+						{Name: "interval_value_nullable", Type: "interval", IsNullable: true, OrderAsc: 6, DatetimePrecision: 6},
 						{Name: "date_value", Type: "date", IsNullable: false, OrderAsc: 7},
-						{Name: "time_value", Type: "time without time zone", IsNullable: false, OrderAsc: 8},
-						{Name: "timestamp_value", Type: "timestamp without time zone", IsNullable: false, OrderAsc: 9},
-						{Name: "timestamptz_value", Type: "timestamp with time zone", IsNullable: false, OrderAsc: 10},
-						{Name: "interval_value", Type: "interval", IsNullable: false, OrderAsc: 11},
+						{Name: "time_value", Type: "time without time zone", IsNullable: false, OrderAsc: 8, DatetimePrecision: 6},
+						{Name: "timestamp_value", Type: "timestamp without time zone", IsNullable: false, OrderAsc: 9, DatetimePrecision: 6},
+						{Name: "timestamptz_value", Type: "timestamp with time zone", IsNullable: false, OrderAsc: 10, DatetimePrecision: 6},
+						{Name: "interval_value", Type: "interval", IsNullable: false, OrderAsc: 11, DatetimePrecision: 6},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "datetime_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX datetime_types_pkey ON public.datetime_types USING btree (id)"},
 					},
 				},
 				{
-					Name: "uuid_types",
+					Schema: "public",
+					Name:   "uuid_types",
+					Kind:   generator.TableKindTable,
 					Columns: []generator.Column{
-						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('uuid_types_id_seq'::regclass)"},
 						{Name: "uuid_value_nullable", Type: "uuid", IsNullable: true, OrderAsc: 2},
 						{Name: "uuid_value", Type: "uuid", IsNullable: false, OrderAsc: 3},
 					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "uuid_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX uuid_types_pkey ON public.uuid_types USING btree (id)"},
+					},
 				},
 				{
-					Name: "money_types",
+					Schema: "public",
+					Name:   "money_types",
+					Kind:   generator.TableKindTable,
 					Columns: []generator.Column{
-						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('money_types_id_seq'::regclass)"},
 						{Name: "money_value_nullable", Type: "money", IsNullable: true, OrderAsc: 2},
 						{Name: "money_value", Type: "money", IsNullable: false, OrderAsc: 3},
 					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "money_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX money_types_pkey ON public.money_types USING btree (id)"},
+					},
 				},
 				{
-					Name: "boolean_types",
+					Schema: "public",
+					Name:   "boolean_types",
+					Kind:   generator.TableKindTable,
 					Columns: []generator.Column{
-						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('boolean_types_id_seq'::regclass)"},
 						{Name: "boolean_value_nullable", Type: "boolean", IsNullable: true, OrderAsc: 2},
 						{Name: "boolean_value", Type: "boolean", IsNullable: false, OrderAsc: 3},
 					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "boolean_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX boolean_types_pkey ON public.boolean_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "json_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('json_types_id_seq'::regclass)"},
+						{Name: "json_value_nullable", Type: "json", IsNullable: true, OrderAsc: 2},
+						{Name: "jsonb_value_nullable", Type: "jsonb", IsNullable: true, OrderAsc: 3},
+						{Name: "jsonpath_value_nullable", Type: "jsonpath", IsNullable: true, OrderAsc: 4},
+						{Name: "json_value", Type: "json", IsNullable: false, OrderAsc: 5},
+						{Name: "jsonb_value", Type: "jsonb", IsNullable: false, OrderAsc: 6},
+						{Name: "jsonpath_value", Type: "jsonpath", IsNullable: false, OrderAsc: 7},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "json_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX json_types_pkey ON public.json_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "binary_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('binary_types_id_seq'::regclass)"},
+						{Name: "bytea_value_nullable", Type: "bytea", IsNullable: true, OrderAsc: 2},
+						{Name: "bytea_value", Type: "bytea", IsNullable: false, OrderAsc: 3},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "binary_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX binary_types_pkey ON public.binary_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "network_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('network_types_id_seq'::regclass)"},
+						{Name: "inet_value_nullable", Type: "inet", IsNullable: true, OrderAsc: 2},
+						{Name: "cidr_value_nullable", Type: "cidr", IsNullable: true, OrderAsc: 3},
+						{Name: "macaddr_value_nullable", Type: "macaddr", IsNullable: true, OrderAsc: 4},
+						{Name: "macaddr8_value_nullable", Type: "macaddr8", IsNullable: true, OrderAsc: 5},
+						{Name: "inet_value", Type: "inet", IsNullable: false, OrderAsc: 6},
+						{Name: "cidr_value", Type: "cidr", IsNullable: false, OrderAsc: 7},
+						{Name: "macaddr_value", Type: "macaddr", IsNullable: false, OrderAsc: 8},
+						{Name: "macaddr8_value", Type: "macaddr8", IsNullable: false, OrderAsc: 9},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "network_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX network_types_pkey ON public.network_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "bit_string_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('bit_string_types_id_seq'::regclass)"},
+						{Name: "bit_value_nullable", Type: "bit", IsNullable: true, OrderAsc: 2, Length: 8},
+						{Name: "bit_varying_value_nullable", Type: "bit varying", IsNullable: true, OrderAsc: 3, Length: 8},
+						{Name: "bit_value", Type: "bit", IsNullable: false, OrderAsc: 4, Length: 8},
+						{Name: "bit_varying_value", Type: "bit varying", IsNullable: false, OrderAsc: 5, Length: 8},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "bit_string_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX bit_string_types_pkey ON public.bit_string_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "xml_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('xml_types_id_seq'::regclass)"},
+						{Name: "xml_value_nullable", Type: "xml", IsNullable: true, OrderAsc: 2},
+						{Name: "xml_value", Type: "xml", IsNullable: false, OrderAsc: 3},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "xml_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX xml_types_pkey ON public.xml_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "text_search_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('text_search_types_id_seq'::regclass)"},
+						{Name: "tsvector_value_nullable", Type: "tsvector", IsNullable: true, OrderAsc: 2},
+						{Name: "tsquery_value_nullable", Type: "tsquery", IsNullable: true, OrderAsc: 3},
+						{Name: "tsvector_value", Type: "tsvector", IsNullable: false, OrderAsc: 4},
+						{Name: "tsquery_value", Type: "tsquery", IsNullable: false, OrderAsc: 5},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "text_search_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX text_search_types_pkey ON public.text_search_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "geometric_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('geometric_types_id_seq'::regclass)"},
+						{Name: "point_value_nullable", Type: "point", IsNullable: true, OrderAsc: 2},
+						{Name: "line_value_nullable", Type: "line", IsNullable: true, OrderAsc: 3},
+						{Name: "lseg_value_nullable", Type: "lseg", IsNullable: true, OrderAsc: 4},
+						{Name: "box_value_nullable", Type: "box", IsNullable: true, OrderAsc: 5},
+						{Name: "path_value_nullable", Type: "path", IsNullable: true, OrderAsc: 6},
+						{Name: "polygon_value_nullable", Type: "polygon", IsNullable: true, OrderAsc: 7},
+						{Name: "circle_value_nullable", Type: "circle", IsNullable: true, OrderAsc: 8},
+						{Name: "point_value", Type: "point", IsNullable: false, OrderAsc: 9},
+						{Name: "line_value", Type: "line", IsNullable: false, OrderAsc: 10},
+						{Name: "lseg_value", Type: "lseg", IsNullable: false, OrderAsc: 11},
+						{Name: "box_value", Type: "box", IsNullable: false, OrderAsc: 12},
+						{Name: "path_value", Type: "path", IsNullable: false, OrderAsc: 13},
+						{Name: "polygon_value", Type: "polygon", IsNullable: false, OrderAsc: 14},
+						{Name: "circle_value", Type: "circle", IsNullable: false, OrderAsc: 15},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "geometric_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX geometric_types_pkey ON public.geometric_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "range_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('range_types_id_seq'::regclass)"},
+						{Name: "int4range_value_nullable", Type: "int4range", IsNullable: true, OrderAsc: 2},
+						{Name: "int8range_value_nullable", Type: "int8range", IsNullable: true, OrderAsc: 3},
+						{Name: "numrange_value_nullable", Type: "numrange", IsNullable: true, OrderAsc: 4},
+						{Name: "tsrange_value_nullable", Type: "tsrange", IsNullable: true, OrderAsc: 5},
+						{Name: "tstzrange_value_nullable", Type: "tstzrange", IsNullable: true, OrderAsc: 6},
+						{Name: "daterange_value_nullable", Type: "daterange", IsNullable: true, OrderAsc: 7},
+						{Name: "int4range_value", Type: "int4range", IsNullable: false, OrderAsc: 8},
+						{Name: "int8range_value", Type: "int8range", IsNullable: false, OrderAsc: 9},
+						{Name: "numrange_value", Type: "numrange", IsNullable: false, OrderAsc: 10},
+						{Name: "tsrange_value", Type: "tsrange", IsNullable: false, OrderAsc: 11},
+						{Name: "tstzrange_value", Type: "tstzrange", IsNullable: false, OrderAsc: 12},
+						{Name: "daterange_value", Type: "daterange", IsNullable: false, OrderAsc: 13},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "range_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX range_types_pkey ON public.range_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "composite_key_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "tenant_id", Type: "integer", IsNullable: false, OrderAsc: 1},
+						{Name: "code", Type: "character varying", IsNullable: false, OrderAsc: 2, Length: 255},
+						{Name: "unique_value", Type: "text", IsNullable: false, OrderAsc: 3},
+						{Name: "indexed_value", Type: "text", IsNullable: true, OrderAsc: 4},
+					},
+					PrimaryKey: []string{"tenant_id", "code"},
+					UniqueConstraints: []generator.UniqueConstraint{
+						{Name: "composite_key_types_unique_value_key", Columns: []string{"unique_value"}},
+					},
+					Indexes: []generator.Index{
+						{Name: "composite_key_types_indexed_value_idx", Columns: []string{"indexed_value"}, Definition: "CREATE INDEX composite_key_types_indexed_value_idx ON public.composite_key_types USING btree (indexed_value)"},
+						{Name: "composite_key_types_lower_indexed_value_idx", IsUnique: true, HasExpression: true, Definition: "CREATE UNIQUE INDEX composite_key_types_lower_indexed_value_idx ON public.composite_key_types USING btree (lower(indexed_value))"},
+						{Name: "composite_key_types_pkey", Columns: []string{"tenant_id", "code"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX composite_key_types_pkey ON public.composite_key_types USING btree (tenant_id, code)"},
+						{Name: "composite_key_types_unique_value_key", Columns: []string{"unique_value"}, IsUnique: true, Definition: "CREATE UNIQUE INDEX composite_key_types_unique_value_key ON public.composite_key_types USING btree (unique_value)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "composite_key_references",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('composite_key_references_id_seq'::regclass)"},
+						{Name: "tenant_id", Type: "integer", IsNullable: false, OrderAsc: 2},
+						{Name: "code", Type: "character varying", IsNullable: false, OrderAsc: 3, Length: 255},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "composite_key_references_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX composite_key_references_pkey ON public.composite_key_references USING btree (id)"},
+					},
+					ForeignKeys: []generator.ForeignKey{
+						{
+							Name:                "composite_key_references_fkey",
+							Columns:             []string{"tenant_id", "code"},
+							RefSchema:           "public",
+							RefTable:            "composite_key_types",
+							RefColumns:          []string{"tenant_id", "code"},
+							OnDelete:            generator.ForeignKeyActionCascade,
+							OnUpdate:            generator.ForeignKeyActionRestrict,
+							IsDeferrable:        true,
+							IsInitiallyDeferred: true,
+						},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "default_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "bigint", IsNullable: false, OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationAlways},
+						{Name: "code", Type: "integer", IsNullable: false, OrderAsc: 2, IdentityGeneration: generator.IdentityGenerationByDefault},
+						{Name: "status", Type: "text", IsNullable: false, OrderAsc: 3, Default: "'active'::text"},
+						{Name: "created_at", Type: "timestamp with time zone", IsNullable: false, OrderAsc: 4, Default: "now()", DatetimePrecision: 6},
+						{Name: "price", Type: "integer", IsNullable: false, OrderAsc: 5},
+						{Name: "qty", Type: "integer", IsNullable: false, OrderAsc: 6, Default: "1"},
+						{Name: "total", Type: "integer", IsNullable: true, OrderAsc: 7, IsGenerated: true},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "default_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX default_types_pkey ON public.default_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "sized_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('sized_types_id_seq'::regclass)"},
+						{Name: "code", Type: "character", IsNullable: false, OrderAsc: 2, Length: 3},
+						{Name: "amount", Type: "numeric", IsNullable: false, OrderAsc: 3, Precision: 12, Scale: 2},
+						{Name: "quantity", Type: "numeric", IsNullable: false, OrderAsc: 4, Precision: 10},
+						{Name: "occurred_at", Type: "timestamp with time zone", IsNullable: false, OrderAsc: 5, DatetimePrecision: 3},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "sized_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX sized_types_pkey ON public.sized_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "authors",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('authors_id_seq'::regclass)"},
+						{Name: "name", Type: "text", IsNullable: false, OrderAsc: 2},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "authors_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX authors_pkey ON public.authors USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "books",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('books_id_seq'::regclass)"},
+						{Name: "author_id", Type: "integer", IsNullable: false, OrderAsc: 2},
+						{Name: "title", Type: "text", IsNullable: false, OrderAsc: 3},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "books_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX books_pkey ON public.books USING btree (id)"},
+					},
+					ForeignKeys: []generator.ForeignKey{
+						{Name: "books_author_id_fkey", Columns: []string{"author_id"}, RefSchema: "public", RefTable: "authors", RefColumns: []string{"id"}, OnDelete: generator.ForeignKeyActionNoAction, OnUpdate: generator.ForeignKeyActionNoAction},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "author_profiles",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('author_profiles_id_seq'::regclass)"},
+						{Name: "author_id", Type: "integer", IsNullable: false, OrderAsc: 2},
+						{Name: "bio", Type: "text", IsNullable: true, OrderAsc: 3},
+					},
+					PrimaryKey: []string{"id"},
+					UniqueConstraints: []generator.UniqueConstraint{
+						{Name: "author_profiles_author_id_key", Columns: []string{"author_id"}},
+					},
+					Indexes: []generator.Index{
+						{Name: "author_profiles_author_id_key", Columns: []string{"author_id"}, IsUnique: true, Definition: "CREATE UNIQUE INDEX author_profiles_author_id_key ON public.author_profiles USING btree (author_id)"},
+						{Name: "author_profiles_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX author_profiles_pkey ON public.author_profiles USING btree (id)"},
+					},
+					ForeignKeys: []generator.ForeignKey{
+						{Name: "author_profiles_author_id_fkey", Columns: []string{"author_id"}, RefSchema: "public", RefTable: "authors", RefColumns: []string{"id"}, OnDelete: generator.ForeignKeyActionNoAction, OnUpdate: generator.ForeignKeyActionNoAction},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "enum_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('enum_types_id_seq'::regclass)"},
+						{Name: "mood_value_nullable", Type: "USER-DEFINED", IsNullable: true, OrderAsc: 2, Enum: "mood", EnumSchema: "public"},
+						{Name: "mood_value", Type: "USER-DEFINED", IsNullable: false, OrderAsc: 3, Enum: "mood", EnumSchema: "public"},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "enum_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX enum_types_pkey ON public.enum_types USING btree (id)"},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "array_types",
+					Kind:   generator.TableKindTable,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('array_types_id_seq'::regclass)"},
+						{Name: "text_array_value_nullable", Type: "ARRAY", IsNullable: true, OrderAsc: 2, ArrayDims: 1, ElemType: "text"},
+						{Name: "integer_array_value", Type: "ARRAY", IsNullable: false, OrderAsc: 3, ArrayDims: 1, ElemType: "integer"},
+						{Name: "uuid_array_value", Type: "ARRAY", IsNullable: false, OrderAsc: 4, ArrayDims: 1, ElemType: "uuid"},
+						{Name: "timestamp_array_value", Type: "ARRAY", IsNullable: false, OrderAsc: 5, ArrayDims: 1, ElemType: "timestamp without time zone"},
+						{Name: "integer_matrix_value", Type: "ARRAY", IsNullable: false, OrderAsc: 6, ArrayDims: 2, ElemType: "integer"},
+						{Name: "mood_array_value", Type: "ARRAY", IsNullable: false, OrderAsc: 7, Enum: "mood", EnumSchema: "public", ArrayDims: 1, ElemType: "USER-DEFINED"},
+					},
+					PrimaryKey: []string{"id"},
+					Indexes: []generator.Index{
+						{Name: "array_types_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX array_types_pkey ON public.array_types USING btree (id)"},
+					},
+				},
+				{
+					Schema:  "public",
+					Name:    "active_authors",
+					Kind:    generator.TableKindView,
+					Comment: "authors with a name",
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+						{Name: "name", Type: "text", IsNullable: false, OrderAsc: 2},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "author_books",
+					Kind:   generator.TableKindView,
+					Columns: []generator.Column{
+						{Name: "id", Type: "integer", IsNullable: true, OrderAsc: 1},
+						{Name: "upper_name", Type: "text", IsNullable: true, OrderAsc: 2},
+						{Name: "title", Type: "text", IsNullable: true, OrderAsc: 3},
+					},
+				},
+				{
+					Schema: "public",
+					Name:   "book_counts",
+					Kind:   generator.TableKindMaterializedView,
+					Columns: []generator.Column{
+						{Name: "author_id", Type: "integer", IsNullable: true, OrderAsc: 1},
+						{Name: "book_count", Type: "bigint", IsNullable: true, OrderAsc: 2},
+					},
 				},
 			}
 
 			t.Run("assert table length", func(t *testing.T) {
-				assert.Len(t, actual, 6)
+				assert.Len(t, actual, 26)
 			})
 			t.Run("assert table column length", func(t *testing.T) {
 				assertTableColumnLength := func(t *testing.T, table string, expected int) {
@@ -217,17 +752,105 @@ func TestLoadTableSchemas(t *testing.T) {
 				assertTableColumnLength(t, "uuid_types", 3)
 				assertTableColumnLength(t, "money_types", 3)
 				assertTableColumnLength(t, "boolean_types", 3)
+				assertTableColumnLength(t, "json_types", 7)
+				assertTableColumnLength(t, "binary_types", 3)
+				assertTableColumnLength(t, "network_types", 9)
+				assertTableColumnLength(t, "bit_string_types", 5)
+				assertTableColumnLength(t, "xml_types", 3)
+				assertTableColumnLength(t, "text_search_types", 5)
+				assertTableColumnLength(t, "geometric_types", 15)
+				assertTableColumnLength(t, "range_types", 13)
+				assertTableColumnLength(t, "composite_key_types", 4)
+				assertTableColumnLength(t, "composite_key_references", 3)
+				assertTableColumnLength(t, "default_types", 7)
+				assertTableColumnLength(t, "sized_types", 5)
+				assertTableColumnLength(t, "authors", 2)
+				// columns are not duplicated by billing.books having the foreign key of the same name
+				assertTableColumnLength(t, "books", 3)
+				assertTableColumnLength(t, "author_profiles", 3)
+				assertTableColumnLength(t, "enum_types", 3)
+				assertTableColumnLength(t, "array_types", 7)
+				assertTableColumnLength(t, "active_authors", 2)
+				assertTableColumnLength(t, "book_counts", 2)
 			})
 
 			t.Run("assert table schema content", func(t *testing.T) {
 				for _, exp := range expected {
-					for _, act := range actual {
-						if exp.Name == act.Name && assert.Equal(t, exp, act) {
-							continue
-						}
+					i := slices.IndexFunc(actual, func(act generator.Table) bool {
+						return act.Schema == exp.Schema && act.Name == exp.Name
+					})
+					if i < 0 {
+						t.Errorf("table %s.%s is not loaded", exp.Schema, exp.Name)
+						continue
 					}
+
+					assert.Equal(t, exp, actual[i])
 				}
 			})
+
+			t.Run("assert enums", func(t *testing.T) {
+				enums, err := ldr.LoadEnums(context.Background())
+				if err != nil {
+					t.Fatalf("failed to load enums: %v", err)
+				}
+
+				assert.Equal(t, []generator.Enum{
+					{Schema: "public", Name: "mood", Comment: "mood of a person", Values: []string{"sad", "ok", "happy"}},
+				}, enums)
+			})
+
+			t.Run("assert multiple schemas", func(t *testing.T) {
+				ldr := NewSchemaLoader(db, "billing", "public")
+
+				tables, err := ldr.LoadTableSchemas(context.Background())
+				if err != nil {
+					t.Fatalf("failed to load table schemas: %v", err)
+				}
+
+				assert.Len(t, tables, len(actual)+2)
+				assert.Equal(t, []generator.Table{
+					{
+						Schema: "billing",
+						Name:   "books",
+						Kind:   generator.TableKindTable,
+						Columns: []generator.Column{
+							{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('billing.books_id_seq'::regclass)"},
+							{Name: "author_id", Type: "integer", IsNullable: true, OrderAsc: 2},
+						},
+						PrimaryKey: []string{"id"},
+						Indexes: []generator.Index{
+							{Name: "books_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX books_pkey ON billing.books USING btree (id)"},
+						},
+						ForeignKeys: []generator.ForeignKey{
+							{Name: "books_author_id_fkey", Columns: []string{"author_id"}, RefSchema: "public", RefTable: "authors", RefColumns: []string{"id"}, OnDelete: generator.ForeignKeyActionSetNull, OnUpdate: generator.ForeignKeyActionNoAction},
+						},
+					},
+					{
+						Schema: "billing",
+						Name:   "invoices",
+						Kind:   generator.TableKindTable,
+						Columns: []generator.Column{
+							{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, Default: "nextval('billing.invoices_id_seq'::regclass)"},
+							{Name: "status", Type: "USER-DEFINED", IsNullable: false, OrderAsc: 2, Enum: "invoice_status", EnumSchema: "billing"},
+							{Name: "mood", Type: "USER-DEFINED", IsNullable: true, OrderAsc: 3, Enum: "mood", EnumSchema: "public"},
+						},
+						PrimaryKey: []string{"id"},
+						Indexes: []generator.Index{
+							{Name: "invoices_pkey", Columns: []string{"id"}, IsUnique: true, IsPrimary: true, Definition: "CREATE UNIQUE INDEX invoices_pkey ON billing.invoices USING btree (id)"},
+						},
+					},
+				}, tables[:2])
+
+				enums, err := ldr.LoadEnums(context.Background())
+				if err != nil {
+					t.Fatalf("failed to load enums: %v", err)
+				}
+
+				assert.Equal(t, []generator.Enum{
+					{Schema: "billing", Name: "invoice_status", Values: []string{"draft", "paid"}},
+					{Schema: "public", Name: "mood", Comment: "mood of a person", Values: []string{"sad", "ok", "happy"}},
+				}, enums)
+			})
 		})
 	}
 }
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	_ "modernc.org/sqlite"
+)
+
+type Opts struct {
+	DataSourceName string
+	MaxOpenConns   int
+	MaxIdleConns   int
+	MaxLifetime    time.Duration
+}
+
+type client struct {
+	sqlDB *sql.DB
+}
+
+func New(o Opts) (*client, error) {
+	db, err := sql.Open("sqlite", o.DataSourceName)
+	if err != nil {
+		return nil, errors.Wrap(err, "error in opening db")
+	}
+
+	db.SetMaxOpenConns(o.MaxOpenConns)
+	db.SetMaxIdleConns(o.MaxIdleConns)
+	db.SetConnMaxLifetime(o.MaxLifetime)
+
+	if err := db.Ping(); err != nil {
+		return nil, errors.Wrap(err, "error in pinging db")
+	}
+
+	return &client{
+		sqlDB: db,
+	}, nil
+}
+
+func (c *client) Close() error {
+	if c.sqlDB == nil {
+		return nil
+	}
+
+	if err := c.sqlDB.Close(); err != nil {
+		return errors.Wrap(err, "error in closing db")
+	}
+
+	return nil
+}
+
+func (c *client) DB() *sql.DB {
+	return c.sqlDB
+}
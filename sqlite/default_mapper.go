@@ -0,0 +1,179 @@
+package sqlite
+
+import (
+	"github.com/kmtym1998/chair/generator/config"
+)
+
+// https://www.sqlite.org/datatype3.html
+// columns of INTEGER, TEXT, BLOB and REAL affinity are typed by the affinity name,
+// and columns of NUMERIC affinity are typed by the declared type name without parameters.
+// date and time types are mapped to time.Time, which the driver parses from the stored text.
+func DefaultMappers() []config.TypeMapping {
+	// https://www.sqlite.org/datatype3.html#type_affinity
+	affinityTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "integer",
+			GoType:     "int64",
+			IsNullable: false,
+		},
+		{
+			DBType:     "text",
+			GoType:     "string",
+			IsNullable: false,
+		},
+		{
+			DBType:     "blob",
+			GoType:     "[]byte",
+			IsNullable: false,
+		},
+		{
+			DBType:     "real",
+			GoType:     "float64",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "integer",
+			GoType:     "NullInt64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "text",
+			GoType:     "NullString",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "blob",
+			GoType:     "[]byte",
+			IsNullable: true,
+		},
+		{
+			DBType:     "real",
+			GoType:     "NullFloat64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// declared types of NUMERIC affinity
+	numericTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "numeric",
+			GoType:     "float64",
+			IsNullable: false,
+		},
+		{
+			DBType:     "decimal",
+			GoType:     "float64",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "numeric",
+			GoType:     "NullFloat64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "decimal",
+			GoType:     "NullFloat64",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.sqlite.org/datatype3.html#boolean_datatype
+	booleanTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "boolean",
+			GoType:     "bool",
+			IsNullable: false,
+		},
+		{
+			DBType:     "bool",
+			GoType:     "bool",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "boolean",
+			GoType:     "NullBool",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "bool",
+			GoType:     "NullBool",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	// https://www.sqlite.org/datatype3.html#date_and_time_datatype
+	datetimeTypes := []config.TypeMapping{
+		// non-nullable
+		{
+			DBType:     "date",
+			GoType:     "Time",
+			GoPkg:      "time",
+			IsNullable: false,
+		},
+		{
+			DBType:     "datetime",
+			GoType:     "Time",
+			GoPkg:      "time",
+			IsNullable: false,
+		},
+		{
+			DBType:     "timestamp",
+			GoType:     "Time",
+			GoPkg:      "time",
+			IsNullable: false,
+		},
+		// nullable
+		{
+			DBType:     "date",
+			GoType:     "NullTime",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "datetime",
+			GoType:     "NullTime",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+		{
+			DBType:     "timestamp",
+			GoType:     "NullTime",
+			GoPkg:      "database/sql",
+			IsNullable: true,
+		},
+	}
+
+	merge := func(arrList ...[]config.TypeMapping) []config.TypeMapping {
+		var itemsCount int
+		for _, arr := range arrList {
+			itemsCount += len(arr)
+		}
+
+		merged := make([]config.TypeMapping, 0, itemsCount)
+		for _, arr := range arrList {
+			merged = append(merged, arr...)
+		}
+
+		return merged
+	}
+
+	return merge(
+		affinityTypes,
+		numericTypes,
+		booleanTypes,
+		datetimeTypes,
+	)
+}
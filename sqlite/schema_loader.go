@@ -0,0 +1,501 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kmtym1998/chair/generator"
+)
+
+type SchemaLoader struct {
+	DB *sql.DB
+}
+
+func NewSchemaLoader(db *sql.DB) *SchemaLoader {
+	return &SchemaLoader{
+		DB: db,
+	}
+}
+
+func (s *SchemaLoader) LoadTableSchemas(ctx context.Context) ([]generator.Table, error) {
+	tables, err := s.listTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryKeys := make(map[string][]string, len(tables))
+	tableSchemas := make([]generator.Table, len(tables))
+	for i, table := range tables {
+		columns, err := s.listColumns(ctx, table.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		indexes, err := s.listIndexes(ctx, table.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		columnSchemas := make([]generator.Column, len(columns))
+		pkColumns := make([]int, 0, len(columns))
+		for j, column := range columns {
+			columnSchemas[j] = generator.Column{
+				Name: column.Name,
+				Type: columnType(column.Type),
+				// a primary key column can be NULL unless it is declared NOT NULL, except for INTEGER PRIMARY KEY below
+				IsNullable:  !column.NotNull,
+				OrderAsc:    column.CID + 1,
+				Default:     column.Default.String,
+				IsGenerated: column.Hidden == hiddenGeneratedVirtual || column.Hidden == hiddenGeneratedStored,
+			}
+			setTypeSizes(&columnSchemas[j], column.Type)
+
+			if column.PK > 0 {
+				pkColumns = append(pkColumns, j)
+			}
+		}
+
+		sort.SliceStable(pkColumns, func(a, b int) bool {
+			return columns[pkColumns[a]].PK < columns[pkColumns[b]].PK
+		})
+
+		var primaryKey []string
+		for _, j := range pkColumns {
+			primaryKey = append(primaryKey, columns[j].Name)
+		}
+		// a single INTEGER PRIMARY KEY is an alias of the rowid, which is never NULL and assigned when it is not given.
+		// WITHOUT ROWID tables have no rowid, and their primary key is an index unlike the rowid alias.
+		isWithoutRowID := slices.ContainsFunc(indexes, func(index Index) bool { return index.Origin == indexOriginPrimaryKey })
+		if len(pkColumns) == 1 && strings.EqualFold(strings.TrimSpace(columns[pkColumns[0]].Type), "integer") && !isWithoutRowID {
+			columnSchemas[pkColumns[0]].IsNullable = false
+			columnSchemas[pkColumns[0]].IdentityGeneration = generator.IdentityGenerationByDefault
+		}
+		primaryKeys[table.Name] = primaryKey
+
+		var (
+			uniqueConstraints []generator.UniqueConstraint
+			indexSchemas      []generator.Index
+		)
+		for _, index := range indexes {
+			indexColumns, err := s.listIndexColumns(ctx, index.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			indexSchema := generator.Index{
+				Name:       index.Name,
+				IsUnique:   index.Unique,
+				IsPrimary:  index.Origin == indexOriginPrimaryKey,
+				IsPartial:  index.Partial,
+				Definition: index.SQL.String,
+			}
+			for _, column := range indexColumns {
+				if column.Name.Valid {
+					indexSchema.Columns = append(indexSchema.Columns, column.Name.String)
+				} else {
+					indexSchema.HasExpression = true
+				}
+			}
+
+			if index.Origin == indexOriginUnique {
+				uniqueConstraints = append(uniqueConstraints, generator.UniqueConstraint{
+					Name:    index.Name,
+					Columns: indexSchema.Columns,
+				})
+			}
+
+			indexSchemas = append(indexSchemas, indexSchema)
+		}
+
+		kind := generator.TableKindTable
+		if table.Type == objectTypeView {
+			kind = generator.TableKindView
+		}
+
+		tableSchemas[i] = generator.Table{
+			Name:              table.Name,
+			Kind:              kind,
+			Columns:           columnSchemas,
+			PrimaryKey:        primaryKey,
+			UniqueConstraints: uniqueConstraints,
+			Indexes:           indexSchemas,
+		}
+	}
+
+	// foreign keys are resolved after all the primary keys are known
+	// since a foreign key may reference the primary key of another table implicitly.
+	for i, table := range tableSchemas {
+		foreignKeys, err := s.listForeignKeys(ctx, table.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		var foreignKeySchemas []generator.ForeignKey
+		for _, fk := range foreignKeys {
+			if fk.Seq == 0 {
+				foreignKeySchemas = append(foreignKeySchemas, generator.ForeignKey{
+					RefTable: fk.RefTable,
+				})
+			}
+
+			last := &foreignKeySchemas[len(foreignKeySchemas)-1]
+			last.Columns = append(last.Columns, fk.From)
+			if fk.To.Valid {
+				last.RefColumns = append(last.RefColumns, fk.To.String)
+			}
+		}
+
+		for j, fk := range foreignKeySchemas {
+			if len(fk.RefColumns) == 0 {
+				foreignKeySchemas[j].RefColumns = primaryKeys[fk.RefTable]
+			}
+
+			// SQLite does not expose the names of foreign key constraints
+			foreignKeySchemas[j].Name = fmt.Sprintf("%s_%s_fkey", table.Name, strings.Join(fk.Columns, "_"))
+		}
+
+		tableSchemas[i].ForeignKeys = foreignKeySchemas
+	}
+
+	return tableSchemas, nil
+}
+
+const (
+	objectTypeView = "view"
+
+	indexOriginPrimaryKey = "pk"
+	indexOriginUnique     = "u"
+
+	// hidden of pragma_table_xinfo. Hidden columns of virtual tables (1) are not loaded.
+	hiddenGeneratedVirtual = 2
+	hiddenGeneratedStored  = 3
+)
+
+// https://www.sqlite.org/datatype3.html#type_affinity
+const (
+	affinityInteger = "integer"
+	affinityText    = "text"
+	affinityBlob    = "blob"
+	affinityReal    = "real"
+	affinityNumeric = "numeric"
+)
+
+// affinity determines the type affinity of the declared type by the rules of SQLite.
+func affinity(declaredType string) string {
+	t := strings.ToUpper(declaredType)
+	switch {
+	case strings.Contains(t, "INT"):
+		return affinityInteger
+	case strings.Contains(t, "CHAR"), strings.Contains(t, "CLOB"), strings.Contains(t, "TEXT"):
+		return affinityText
+	case strings.Contains(t, "BLOB"), t == "":
+		return affinityBlob
+	case strings.Contains(t, "REAL"), strings.Contains(t, "FLOA"), strings.Contains(t, "DOUB"):
+		return affinityReal
+	default:
+		return affinityNumeric
+	}
+}
+
+var (
+	typeParamsRegex = regexp.MustCompile(`\s*\(.*\)\s*$`)
+	typeSizesRegex  = regexp.MustCompile(`\(\s*(\d+)\s*(?:,\s*(\d+)\s*)?\)\s*$`)
+)
+
+// setTypeSizes sets the length of text columns and the precision and the scale of decimal columns from the parameters
+// of the declared type, e.g. VARCHAR(255) and DECIMAL(10, 2). SQLite does not enforce them, so they are as declared.
+func setTypeSizes(column *generator.Column, declaredType string) {
+	m := typeSizesRegex.FindStringSubmatch(declaredType)
+	if m == nil {
+		return
+	}
+
+	first, _ := strconv.Atoi(m[1])
+	second, _ := strconv.Atoi(m[2])
+	switch column.Type {
+	case affinityText:
+		column.Length = first
+	case "decimal", "numeric":
+		column.Precision = first
+		column.Scale = second
+	}
+}
+
+// columnType returns the type used for mappings.
+// Columns of INTEGER, TEXT, BLOB and REAL affinity are typed by the affinity.
+// Columns of NUMERIC affinity keep the declared type name without parameters (e.g. "decimal", "boolean", "datetime")
+// since NUMERIC affinity is the fallback of unknown declared types and stores both numbers and text.
+func columnType(declaredType string) string {
+	if a := affinity(declaredType); a != affinityNumeric {
+		return a
+	}
+
+	t := typeParamsRegex.ReplaceAllString(declaredType, "")
+	t = strings.Join(strings.Fields(strings.ToLower(t)), " ")
+
+	return t
+}
+
+type Table struct {
+	Type string `db:"type"`
+	Name string `db:"name"`
+}
+
+func (s *SchemaLoader) listTables(ctx context.Context) ([]Table, error) {
+	const query = `
+SELECT
+	type,
+	name
+FROM
+	sqlite_master
+WHERE
+	type IN ('table', 'view')
+	AND name NOT LIKE 'sqlite_%'
+ORDER BY
+	name ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query))
+
+	rows, err := s.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []Table
+	for rows.Next() {
+		var table Table
+		if err := rows.Scan(
+			&table.Type,
+			&table.Name,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan tables: %w", err)
+		}
+
+		tables = append(tables, table)
+	}
+
+	return tables, rows.Err()
+}
+
+type Column struct {
+	CID     int    `db:"cid"`
+	Name    string `db:"name"`
+	Type    string `db:"type"`
+	NotNull bool   `db:"notnull"`
+	PK      int    `db:"pk"`
+	// Default is NULL when the column has no default.
+	Default sql.NullString `db:"dflt_value"`
+	Hidden  int            `db:"hidden"`
+}
+
+func (s *SchemaLoader) listColumns(ctx context.Context, table string) ([]Column, error) {
+	const query = `
+SELECT
+	cid,
+	name,
+	type,
+	"notnull",
+	pk,
+	dflt_value,
+	hidden
+FROM
+	-- pragma_table_info omits generated columns
+	pragma_table_xinfo(?)
+WHERE
+	hidden <> 1
+ORDER BY
+	cid ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "table", table)
+
+	rows, err := s.DB.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var columns []Column
+	for rows.Next() {
+		var column Column
+		if err := rows.Scan(
+			&column.CID,
+			&column.Name,
+			&column.Type,
+			&column.NotNull,
+			&column.PK,
+			&column.Default,
+			&column.Hidden,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan columns: %w", err)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, rows.Err()
+}
+
+type Index struct {
+	Name    string         `db:"name"`
+	Unique  bool           `db:"unique"`
+	Origin  string         `db:"origin"`
+	Partial bool           `db:"partial"`
+	SQL     sql.NullString `db:"sql"`
+}
+
+// listIndexes lists indexes of the table. sql is NULL for indexes created by constraints.
+func (s *SchemaLoader) listIndexes(ctx context.Context, table string) ([]Index, error) {
+	const query = `
+SELECT
+	il.name,
+	il."unique",
+	il.origin,
+	il.partial,
+	m.sql
+FROM
+	pragma_index_list(?) AS il
+	LEFT JOIN sqlite_master AS m ON m.type = 'index' AND m.name = il.name
+ORDER BY
+	il.name ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "table", table)
+
+	rows, err := s.DB.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var indexes []Index
+	for rows.Next() {
+		var index Index
+		if err := rows.Scan(
+			&index.Name,
+			&index.Unique,
+			&index.Origin,
+			&index.Partial,
+			&index.SQL,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan indexes: %w", err)
+		}
+
+		indexes = append(indexes, index)
+	}
+
+	return indexes, rows.Err()
+}
+
+type IndexColumn struct {
+	SeqNo int            `db:"seqno"`
+	Name  sql.NullString `db:"name"`
+}
+
+// listIndexColumns lists key columns of the index. name is NULL for expressions.
+func (s *SchemaLoader) listIndexColumns(ctx context.Context, index string) ([]IndexColumn, error) {
+	const query = `
+SELECT
+	seqno,
+	name
+FROM
+	pragma_index_info(?)
+ORDER BY
+	seqno ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "index", index)
+
+	rows, err := s.DB.QueryContext(ctx, query, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var columns []IndexColumn
+	for rows.Next() {
+		var column IndexColumn
+		if err := rows.Scan(
+			&column.SeqNo,
+			&column.Name,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan index columns: %w", err)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, rows.Err()
+}
+
+type ForeignKey struct {
+	ID       int            `db:"id"`
+	Seq      int            `db:"seq"`
+	RefTable string         `db:"table"`
+	From     string         `db:"from"`
+	To       sql.NullString `db:"to"`
+}
+
+// listForeignKeys lists foreign keys of the table with one row per column.
+// "to" is NULL when the foreign key references the primary key of the referenced table implicitly.
+func (s *SchemaLoader) listForeignKeys(ctx context.Context, table string) ([]ForeignKey, error) {
+	const query = `
+SELECT
+	id,
+	seq,
+	"table",
+	"from",
+	"to"
+FROM
+	pragma_foreign_key_list(?)
+ORDER BY
+	id DESC,
+	seq ASC
+;`
+	slog.Debug("executing query", "query", normalizeQuery(query), "table", table)
+
+	rows, err := s.DB.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var foreignKeys []ForeignKey
+	for rows.Next() {
+		var fk ForeignKey
+		if err := rows.Scan(
+			&fk.ID,
+			&fk.Seq,
+			&fk.RefTable,
+			&fk.From,
+			&fk.To,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan foreign keys: %w", err)
+		}
+
+		foreignKeys = append(foreignKeys, fk)
+	}
+
+	return foreignKeys, rows.Err()
+}
+
+func normalizeQuery(query string) string {
+	tabAndNewlineRegex := regexp.MustCompile("[\t\n]")
+	replaced := tabAndNewlineRegex.ReplaceAllString(query, " ")
+
+	spaceRegex := regexp.MustCompile(`\s+`)
+	replaced = spaceRegex.ReplaceAllString(replaced, " ")
+
+	replaced = strings.Trim(replaced, " ")
+
+	return replaced
+}
+
+func (s *SchemaLoader) Dialect() generator.Dialect {
+	return generator.DialectSQLite
+}
@@ -0,0 +1,249 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"testing"
+
+	"github.com/kmtym1998/chair/generator"
+	"github.com/stretchr/testify/assert"
+	_ "modernc.org/sqlite"
+)
+
+var ddlList = []string{
+	"CREATE TABLE numeric_types (" +
+		"id INTEGER PRIMARY KEY," +
+		"int_value INT NOT NULL," +
+		"real_value REAL," +
+		"decimal_value DECIMAL(10, 2) NOT NULL," +
+		"bool_value BOOLEAN NOT NULL," +
+		"created_at DATETIME DEFAULT CURRENT_TIMESTAMP" +
+		");",
+	"CREATE TABLE users (" +
+		"id INTEGER PRIMARY KEY AUTOINCREMENT," +
+		"email VARCHAR(255) NOT NULL UNIQUE," +
+		"name TEXT," +
+		"avatar BLOB" +
+		");",
+	"CREATE INDEX users_lower_name_idx ON users (lower(name));",
+	"CREATE TABLE posts (" +
+		"id INTEGER PRIMARY KEY," +
+		"user_id INTEGER NOT NULL REFERENCES users," +
+		"title TEXT NOT NULL" +
+		");",
+	"CREATE INDEX posts_user_id_idx ON posts (user_id) WHERE title <> '';",
+	"CREATE TABLE user_roles (" +
+		"user_id INTEGER NOT NULL," +
+		"role TEXT," +
+		"PRIMARY KEY (role, user_id)," +
+		"FOREIGN KEY (user_id) REFERENCES users (id)" +
+		");",
+	// only a single INTEGER PRIMARY KEY is an alias of the rowid, which cannot be NULL
+	"CREATE TABLE tag_links (" +
+		"post_id INTEGER," +
+		"tag_id INTEGER," +
+		"PRIMARY KEY (post_id, tag_id)" +
+		");",
+	"CREATE TABLE short_codes (code INT PRIMARY KEY);",
+	// the INTEGER PRIMARY KEY of a WITHOUT ROWID table is not an alias of the rowid
+	"CREATE TABLE counters (id INTEGER PRIMARY KEY, hits INTEGER NOT NULL) WITHOUT ROWID;",
+	"CREATE TABLE line_items (" +
+		"id INTEGER PRIMARY KEY," +
+		"quantity INTEGER NOT NULL," +
+		"unit_price REAL NOT NULL," +
+		"total REAL GENERATED ALWAYS AS (quantity * unit_price) STORED," +
+		"label TEXT AS ('x' || quantity) VIRTUAL" +
+		");",
+	"CREATE VIEW active_users AS SELECT id, email FROM users WHERE name IS NOT NULL;",
+}
+
+func TestLoadTableSchemas(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open sqlite database: %v", err)
+	}
+	defer db.Close()
+
+	// each connection of an in-memory database has its own database
+	db.SetMaxOpenConns(1)
+
+	for _, ddl := range ddlList {
+		_, err := db.Exec(ddl)
+		if err != nil {
+			t.Fatalf("failed to create table: %v", err)
+		}
+	}
+
+	ldr := NewSchemaLoader(db)
+	actual, err := ldr.LoadTableSchemas(context.Background())
+	if err != nil {
+		t.Fatalf("failed to load table schemas: %v", err)
+	}
+
+	expected := []generator.Table{
+		{
+			Name: "active_users",
+			Kind: generator.TableKindView,
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", IsNullable: true, OrderAsc: 1},
+				{Name: "email", Type: "text", IsNullable: true, OrderAsc: 2, Length: 255},
+			},
+		},
+		{
+			Name: "counters",
+			Kind: generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1},
+				{Name: "hits", Type: "integer", IsNullable: false, OrderAsc: 2},
+			},
+			PrimaryKey: []string{"id"},
+			Indexes: []generator.Index{
+				{Name: "sqlite_autoindex_counters_1", Columns: []string{"id"}, IsUnique: true, IsPrimary: true},
+			},
+		},
+		{
+			Name: "line_items",
+			Kind: generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationByDefault},
+				{Name: "quantity", Type: "integer", IsNullable: false, OrderAsc: 2},
+				{Name: "unit_price", Type: "real", IsNullable: false, OrderAsc: 3},
+				{Name: "total", Type: "real", IsNullable: true, OrderAsc: 4, IsGenerated: true},
+				{Name: "label", Type: "text", IsNullable: true, OrderAsc: 5, IsGenerated: true},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Name: "numeric_types",
+			Kind: generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationByDefault},
+				{Name: "int_value", Type: "integer", IsNullable: false, OrderAsc: 2},
+				{Name: "real_value", Type: "real", IsNullable: true, OrderAsc: 3},
+				{Name: "decimal_value", Type: "decimal", IsNullable: false, OrderAsc: 4, Precision: 10, Scale: 2},
+				{Name: "bool_value", Type: "boolean", IsNullable: false, OrderAsc: 5},
+				{Name: "created_at", Type: "datetime", IsNullable: true, OrderAsc: 6, Default: "CURRENT_TIMESTAMP"},
+			},
+			PrimaryKey: []string{"id"},
+		},
+		{
+			Name: "posts",
+			Kind: generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationByDefault},
+				{Name: "user_id", Type: "integer", IsNullable: false, OrderAsc: 2},
+				{Name: "title", Type: "text", IsNullable: false, OrderAsc: 3},
+			},
+			PrimaryKey: []string{"id"},
+			Indexes: []generator.Index{
+				{Name: "posts_user_id_idx", Columns: []string{"user_id"}, IsPartial: true, Definition: "CREATE INDEX posts_user_id_idx ON posts (user_id) WHERE title <> ''"},
+			},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "posts_user_id_fkey", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+			},
+		},
+		{
+			Name: "short_codes",
+			Kind: generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "code", Type: "integer", IsNullable: true, OrderAsc: 1},
+			},
+			PrimaryKey: []string{"code"},
+			Indexes: []generator.Index{
+				{Name: "sqlite_autoindex_short_codes_1", Columns: []string{"code"}, IsUnique: true, IsPrimary: true},
+			},
+		},
+		{
+			Name: "tag_links",
+			Kind: generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "post_id", Type: "integer", IsNullable: true, OrderAsc: 1},
+				{Name: "tag_id", Type: "integer", IsNullable: true, OrderAsc: 2},
+			},
+			PrimaryKey: []string{"post_id", "tag_id"},
+			Indexes: []generator.Index{
+				{Name: "sqlite_autoindex_tag_links_1", Columns: []string{"post_id", "tag_id"}, IsUnique: true, IsPrimary: true},
+			},
+		},
+		{
+			Name: "user_roles",
+			Kind: generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "user_id", Type: "integer", IsNullable: false, OrderAsc: 1},
+				{Name: "role", Type: "text", IsNullable: true, OrderAsc: 2},
+			},
+			PrimaryKey: []string{"role", "user_id"},
+			Indexes: []generator.Index{
+				{Name: "sqlite_autoindex_user_roles_1", Columns: []string{"role", "user_id"}, IsUnique: true, IsPrimary: true},
+			},
+			ForeignKeys: []generator.ForeignKey{
+				{Name: "user_roles_user_id_fkey", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+			},
+		},
+		{
+			Name: "users",
+			Kind: generator.TableKindTable,
+			Columns: []generator.Column{
+				{Name: "id", Type: "integer", IsNullable: false, OrderAsc: 1, IdentityGeneration: generator.IdentityGenerationByDefault},
+				{Name: "email", Type: "text", IsNullable: false, OrderAsc: 2, Length: 255},
+				{Name: "name", Type: "text", IsNullable: true, OrderAsc: 3},
+				{Name: "avatar", Type: "blob", IsNullable: true, OrderAsc: 4},
+			},
+			PrimaryKey: []string{"id"},
+			UniqueConstraints: []generator.UniqueConstraint{
+				{Name: "sqlite_autoindex_users_1", Columns: []string{"email"}},
+			},
+			Indexes: []generator.Index{
+				{Name: "sqlite_autoindex_users_1", Columns: []string{"email"}, IsUnique: true},
+				{Name: "users_lower_name_idx", HasExpression: true, Definition: "CREATE INDEX users_lower_name_idx ON users (lower(name))"},
+			},
+		},
+	}
+
+	t.Run("assert table length", func(t *testing.T) {
+		assert.Len(t, actual, 9)
+	})
+
+	t.Run("assert table schema content", func(t *testing.T) {
+		for _, exp := range expected {
+			i := slices.IndexFunc(actual, func(act generator.Table) bool {
+				return act.Schema == exp.Schema && act.Name == exp.Name
+			})
+			if i < 0 {
+				t.Errorf("table %s.%s is not loaded", exp.Schema, exp.Name)
+				continue
+			}
+
+			assert.Equal(t, exp, actual[i])
+		}
+	})
+}
+
+func TestColumnType(t *testing.T) {
+	tests := []struct {
+		declaredType string
+		want         string
+	}{
+		{"INTEGER", "integer"},
+		{"BIGINT", "integer"},
+		{"VARCHAR(255)", "text"},
+		{"CLOB", "text"},
+		{"BLOB", "blob"},
+		{"", "blob"},
+		{"DOUBLE PRECISION", "real"},
+		{"FLOAT", "real"},
+		{"DECIMAL(10, 2)", "decimal"},
+		{"BOOLEAN", "boolean"},
+		{"DATETIME", "datetime"},
+		// "POINT" contains "INT" and has INTEGER affinity by the rules of SQLite
+		{"POINT", "integer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.declaredType, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, columnType(tt.declaredType))
+		})
+	}
+}
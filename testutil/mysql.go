@@ -0,0 +1,109 @@
+package testutil
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+)
+
+type MySQLContainer struct {
+	resource *dockertest.Resource
+	pool     *dockertest.Pool
+	dbOpts   TestDBOptions
+}
+
+// NewMySQLContainer starts a container of the repository, e.g. "mysql" or "mariadb".
+func NewMySQLContainer(t *testing.T, repository, ver string, o TestDBOptions) (*MySQLContainer, error) {
+	t.Helper()
+
+	c := &MySQLContainer{
+		dbOpts: o,
+	}
+
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		return nil, fmt.Errorf("could not construct pool: %w", err)
+	}
+
+	if err := pool.Client.Ping(); err != nil {
+		return nil, fmt.Errorf("could not connect to Docker: %w", err)
+	}
+
+	c.pool = pool
+
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: repository,
+		Tag:        ver,
+		Env: []string{
+			"MYSQL_ROOT_PASSWORD=" + o.Password,
+			"MYSQL_USER=" + o.User,
+			"MYSQL_PASSWORD=" + o.Password,
+			"MYSQL_DATABASE=" + o.DBName,
+			"MARIADB_ROOT_PASSWORD=" + o.Password,
+			"MARIADB_USER=" + o.User,
+			"MARIADB_PASSWORD=" + o.Password,
+			"MARIADB_DATABASE=" + o.DBName,
+		},
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true                                // resource の Purge 後にコンテナを削除する
+		config.RestartPolicy = docker.RestartPolicy{Name: "no"} // コンテナの再起動を行わない
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not start resource: %w", err)
+	}
+
+	c.resource = resource
+
+	return c, nil
+}
+
+func (c *MySQLContainer) ConnectDB() (*sql.DB, error) {
+	if c.pool == nil || c.resource == nil {
+		return nil, fmt.Errorf("MySQLContainer is not initialized")
+	}
+
+	dsn := fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true&multiStatements=true",
+		c.dbOpts.User,
+		c.dbOpts.Password,
+		c.resource.GetHostPort("3306/tcp"),
+		c.dbOpts.DBName,
+	)
+
+	// NOTE: MySQL takes longer than PostgreSQL to accept connections after the container is up and running.
+	var db *sql.DB
+	c.pool.MaxWait = 60 * time.Second
+	if err := c.pool.Retry(func() error {
+		innerDB, err := sql.Open("mysql", dsn)
+		if err != nil {
+			return err
+		}
+
+		if err := innerDB.Ping(); err != nil {
+			return fmt.Errorf("could not ping database: %w", err)
+		}
+
+		db = innerDB
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not connect to docker: %w", err)
+	}
+
+	return db, nil
+}
+
+func (c *MySQLContainer) Purge() {
+	if c.pool == nil || c.resource == nil {
+		return
+	}
+
+	if err := c.pool.Purge(c.resource); err != nil {
+		log.Panicf("could not purge resource: %s", err)
+	}
+}